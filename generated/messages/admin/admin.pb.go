@@ -0,0 +1,1014 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v3.12.4
+// source: messages/admin/admin.proto
+
+package admin
+
+import (
+	common "github.com/PonomarevAlexxander/queuing-system/messages/common"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetBufferCapacityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Capacity uint64 `protobuf:"varint,1,opt,name=capacity,proto3" json:"capacity,omitempty"`
+}
+
+func (x *SetBufferCapacityReq) Reset() {
+	*x = SetBufferCapacityReq{}
+	mi := &file_messages_admin_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBufferCapacityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBufferCapacityReq) ProtoMessage() {}
+
+func (x *SetBufferCapacityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBufferCapacityReq.ProtoReflect.Descriptor instead.
+func (*SetBufferCapacityReq) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetBufferCapacityReq) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type SetBufferCapacityResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result  *common.Result `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Evicted uint64         `protobuf:"varint,2,opt,name=evicted,proto3" json:"evicted,omitempty"` // number of incedents evicted by shrinking
+}
+
+func (x *SetBufferCapacityResp) Reset() {
+	*x = SetBufferCapacityResp{}
+	mi := &file_messages_admin_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBufferCapacityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBufferCapacityResp) ProtoMessage() {}
+
+func (x *SetBufferCapacityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBufferCapacityResp.ProtoReflect.Descriptor instead.
+func (*SetBufferCapacityResp) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetBufferCapacityResp) GetResult() *common.Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *SetBufferCapacityResp) GetEvicted() uint64 {
+	if x != nil {
+		return x.Evicted
+	}
+	return 0
+}
+
+type SetEvictionPolicyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy string `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *SetEvictionPolicyReq) Reset() {
+	*x = SetEvictionPolicyReq{}
+	mi := &file_messages_admin_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEvictionPolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEvictionPolicyReq) ProtoMessage() {}
+
+func (x *SetEvictionPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEvictionPolicyReq.ProtoReflect.Descriptor instead.
+func (*SetEvictionPolicyReq) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetEvictionPolicyReq) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+type SetEvictionPolicyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *common.Result `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *SetEvictionPolicyResp) Reset() {
+	*x = SetEvictionPolicyResp{}
+	mi := &file_messages_admin_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEvictionPolicyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEvictionPolicyResp) ProtoMessage() {}
+
+func (x *SetEvictionPolicyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEvictionPolicyResp.ProtoReflect.Descriptor instead.
+func (*SetEvictionPolicyResp) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetEvictionPolicyResp) GetResult() *common.Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type SetSelectionStrategyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strategy string `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+}
+
+func (x *SetSelectionStrategyReq) Reset() {
+	*x = SetSelectionStrategyReq{}
+	mi := &file_messages_admin_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSelectionStrategyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSelectionStrategyReq) ProtoMessage() {}
+
+func (x *SetSelectionStrategyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSelectionStrategyReq.ProtoReflect.Descriptor instead.
+func (*SetSelectionStrategyReq) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetSelectionStrategyReq) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+type SetSelectionStrategyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *common.Result `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *SetSelectionStrategyResp) Reset() {
+	*x = SetSelectionStrategyResp{}
+	mi := &file_messages_admin_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSelectionStrategyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSelectionStrategyResp) ProtoMessage() {}
+
+func (x *SetSelectionStrategyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSelectionStrategyResp.ProtoReflect.Descriptor instead.
+func (*SetSelectionStrategyResp) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetSelectionStrategyResp) GetResult() *common.Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DrainProcessorReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DrainProcessorReq) Reset() {
+	*x = DrainProcessorReq{}
+	mi := &file_messages_admin_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainProcessorReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainProcessorReq) ProtoMessage() {}
+
+func (x *DrainProcessorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainProcessorReq.ProtoReflect.Descriptor instead.
+func (*DrainProcessorReq) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DrainProcessorReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DrainProcessorResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *common.Result `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *DrainProcessorResp) Reset() {
+	*x = DrainProcessorResp{}
+	mi := &file_messages_admin_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainProcessorResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainProcessorResp) ProtoMessage() {}
+
+func (x *DrainProcessorResp) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainProcessorResp.ProtoReflect.Descriptor instead.
+func (*DrainProcessorResp) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DrainProcessorResp) GetResult() *common.Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DeregisterProcessorReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeregisterProcessorReq) Reset() {
+	*x = DeregisterProcessorReq{}
+	mi := &file_messages_admin_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeregisterProcessorReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterProcessorReq) ProtoMessage() {}
+
+func (x *DeregisterProcessorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterProcessorReq.ProtoReflect.Descriptor instead.
+func (*DeregisterProcessorReq) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeregisterProcessorReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeregisterProcessorResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *common.Result `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *DeregisterProcessorResp) Reset() {
+	*x = DeregisterProcessorResp{}
+	mi := &file_messages_admin_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeregisterProcessorResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterProcessorResp) ProtoMessage() {}
+
+func (x *DeregisterProcessorResp) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterProcessorResp.ProtoReflect.Descriptor instead.
+func (*DeregisterProcessorResp) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeregisterProcessorResp) GetResult() *common.Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ListProcessorsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListProcessorsReq) Reset() {
+	*x = ListProcessorsReq{}
+	mi := &file_messages_admin_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProcessorsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProcessorsReq) ProtoMessage() {}
+
+func (x *ListProcessorsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProcessorsReq.ProtoReflect.Descriptor instead.
+func (*ListProcessorsReq) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{10}
+}
+
+type ProcessorInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Host     string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Weight   uint32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Capacity uint32 `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Busy     uint32 `protobuf:"varint,5,opt,name=busy,proto3" json:"busy,omitempty"` // number of slots sending incedents
+	Draining bool   `protobuf:"varint,6,opt,name=draining,proto3" json:"draining,omitempty"`
+}
+
+func (x *ProcessorInfo) Reset() {
+	*x = ProcessorInfo{}
+	mi := &file_messages_admin_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessorInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessorInfo) ProtoMessage() {}
+
+func (x *ProcessorInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessorInfo.ProtoReflect.Descriptor instead.
+func (*ProcessorInfo) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProcessorInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProcessorInfo) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ProcessorInfo) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ProcessorInfo) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *ProcessorInfo) GetBusy() uint32 {
+	if x != nil {
+		return x.Busy
+	}
+	return 0
+}
+
+func (x *ProcessorInfo) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+type ListProcessorsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Processors []*ProcessorInfo `protobuf:"bytes,1,rep,name=processors,proto3" json:"processors,omitempty"`
+}
+
+func (x *ListProcessorsResp) Reset() {
+	*x = ListProcessorsResp{}
+	mi := &file_messages_admin_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProcessorsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProcessorsResp) ProtoMessage() {}
+
+func (x *ListProcessorsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProcessorsResp.ProtoReflect.Descriptor instead.
+func (*ListProcessorsResp) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListProcessorsResp) GetProcessors() []*ProcessorInfo {
+	if x != nil {
+		return x.Processors
+	}
+	return nil
+}
+
+type GetBufferReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetBufferReq) Reset() {
+	*x = GetBufferReq{}
+	mi := &file_messages_admin_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBufferReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBufferReq) ProtoMessage() {}
+
+func (x *GetBufferReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBufferReq.ProtoReflect.Descriptor instead.
+func (*GetBufferReq) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{13}
+}
+
+type BufferedIncedent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Time *timestamp.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *BufferedIncedent) Reset() {
+	*x = BufferedIncedent{}
+	mi := &file_messages_admin_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BufferedIncedent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BufferedIncedent) ProtoMessage() {}
+
+func (x *BufferedIncedent) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BufferedIncedent.ProtoReflect.Descriptor instead.
+func (*BufferedIncedent) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BufferedIncedent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BufferedIncedent) GetTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type PriorityBuffer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Priority  uint64              `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Incedents []*BufferedIncedent `protobuf:"bytes,2,rep,name=incedents,proto3" json:"incedents,omitempty"` // from the oldest one
+}
+
+func (x *PriorityBuffer) Reset() {
+	*x = PriorityBuffer{}
+	mi := &file_messages_admin_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriorityBuffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriorityBuffer) ProtoMessage() {}
+
+func (x *PriorityBuffer) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriorityBuffer.ProtoReflect.Descriptor instead.
+func (*PriorityBuffer) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PriorityBuffer) GetPriority() uint64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *PriorityBuffer) GetIncedents() []*BufferedIncedent {
+	if x != nil {
+		return x.Incedents
+	}
+	return nil
+}
+
+type GetBufferResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Capacity   uint64            `protobuf:"varint,1,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Size       uint64            `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`            // includes incedents taken for processing
+	Priorities []*PriorityBuffer `protobuf:"bytes,3,rep,name=priorities,proto3" json:"priorities,omitempty"` // from the highest priority
+}
+
+func (x *GetBufferResp) Reset() {
+	*x = GetBufferResp{}
+	mi := &file_messages_admin_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBufferResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBufferResp) ProtoMessage() {}
+
+func (x *GetBufferResp) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_admin_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBufferResp.ProtoReflect.Descriptor instead.
+func (*GetBufferResp) Descriptor() ([]byte, []int) {
+	return file_messages_admin_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetBufferResp) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *GetBufferResp) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetBufferResp) GetPriorities() []*PriorityBuffer {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+var File_messages_admin_admin_proto protoreflect.FileDescriptor
+
+var file_messages_admin_admin_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x32, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x43, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0x59, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x42, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64,
+	0x22, 0x2e, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x45, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x22, 0x3f, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x45, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x35, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0x42, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x23, 0x0a, 0x11,
+	0x44, 0x72, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x3c, 0x0a, 0x12, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x28, 0x0a, 0x16, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x17, 0x44, 0x65, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x13, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x22, 0x97, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x62,
+	0x75, 0x73, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x75, 0x73, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x4a, 0x0a, 0x12, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x22, 0x0e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x22, 0x52, 0x0a, 0x10, 0x42, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x65, 0x64, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x63, 0x0a, 0x0e, 0x50,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x09, 0x69, 0x6e, 0x63,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x63,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x76, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x52, 0x0a, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x50, 0x6f, 0x6e, 0x6f, 0x6d, 0x61, 0x72, 0x65, 0x76,
+	0x41, 0x6c, 0x65, 0x78, 0x78, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x69,
+	0x6e, 0x67, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_messages_admin_admin_proto_rawDescOnce sync.Once
+	file_messages_admin_admin_proto_rawDescData = file_messages_admin_admin_proto_rawDesc
+)
+
+func file_messages_admin_admin_proto_rawDescGZIP() []byte {
+	file_messages_admin_admin_proto_rawDescOnce.Do(func() {
+		file_messages_admin_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_messages_admin_admin_proto_rawDescData)
+	})
+	return file_messages_admin_admin_proto_rawDescData
+}
+
+var file_messages_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_messages_admin_admin_proto_goTypes = []any{
+	(*SetBufferCapacityReq)(nil),     // 0: admin.SetBufferCapacityReq
+	(*SetBufferCapacityResp)(nil),    // 1: admin.SetBufferCapacityResp
+	(*SetEvictionPolicyReq)(nil),     // 2: admin.SetEvictionPolicyReq
+	(*SetEvictionPolicyResp)(nil),    // 3: admin.SetEvictionPolicyResp
+	(*SetSelectionStrategyReq)(nil),  // 4: admin.SetSelectionStrategyReq
+	(*SetSelectionStrategyResp)(nil), // 5: admin.SetSelectionStrategyResp
+	(*DrainProcessorReq)(nil),        // 6: admin.DrainProcessorReq
+	(*DrainProcessorResp)(nil),       // 7: admin.DrainProcessorResp
+	(*DeregisterProcessorReq)(nil),   // 8: admin.DeregisterProcessorReq
+	(*DeregisterProcessorResp)(nil),  // 9: admin.DeregisterProcessorResp
+	(*ListProcessorsReq)(nil),        // 10: admin.ListProcessorsReq
+	(*ProcessorInfo)(nil),            // 11: admin.ProcessorInfo
+	(*ListProcessorsResp)(nil),       // 12: admin.ListProcessorsResp
+	(*GetBufferReq)(nil),             // 13: admin.GetBufferReq
+	(*BufferedIncedent)(nil),         // 14: admin.BufferedIncedent
+	(*PriorityBuffer)(nil),           // 15: admin.PriorityBuffer
+	(*GetBufferResp)(nil),            // 16: admin.GetBufferResp
+	(*common.Result)(nil),            // 17: common.Result
+	(*timestamp.Timestamp)(nil),      // 18: google.protobuf.Timestamp
+}
+var file_messages_admin_admin_proto_depIdxs = []int32{
+	17, // 0: admin.SetBufferCapacityResp.result:type_name -> common.Result
+	17, // 1: admin.SetEvictionPolicyResp.result:type_name -> common.Result
+	17, // 2: admin.SetSelectionStrategyResp.result:type_name -> common.Result
+	17, // 3: admin.DrainProcessorResp.result:type_name -> common.Result
+	17, // 4: admin.DeregisterProcessorResp.result:type_name -> common.Result
+	11, // 5: admin.ListProcessorsResp.processors:type_name -> admin.ProcessorInfo
+	18, // 6: admin.BufferedIncedent.time:type_name -> google.protobuf.Timestamp
+	14, // 7: admin.PriorityBuffer.incedents:type_name -> admin.BufferedIncedent
+	15, // 8: admin.GetBufferResp.priorities:type_name -> admin.PriorityBuffer
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_messages_admin_admin_proto_init() }
+func file_messages_admin_admin_proto_init() {
+	if File_messages_admin_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_messages_admin_admin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_messages_admin_admin_proto_goTypes,
+		DependencyIndexes: file_messages_admin_admin_proto_depIdxs,
+		MessageInfos:      file_messages_admin_admin_proto_msgTypes,
+	}.Build()
+	File_messages_admin_admin_proto = out.File
+	file_messages_admin_admin_proto_rawDesc = nil
+	file_messages_admin_admin_proto_goTypes = nil
+	file_messages_admin_admin_proto_depIdxs = nil
+}
@@ -22,6 +22,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type IncedentStatus int32
+
+const (
+	IncedentStatus_INCEDENT_STATUS_UNKNOWN IncedentStatus = 0
+	IncedentStatus_IN_BUFFER               IncedentStatus = 1
+	IncedentStatus_IN_PROCESSING           IncedentStatus = 2
+	IncedentStatus_PROCESSED               IncedentStatus = 3
+	IncedentStatus_REJECTED                IncedentStatus = 4
+)
+
+// Enum value maps for IncedentStatus.
+var (
+	IncedentStatus_name = map[int32]string{
+		0: "INCEDENT_STATUS_UNKNOWN",
+		1: "IN_BUFFER",
+		2: "IN_PROCESSING",
+		3: "PROCESSED",
+		4: "REJECTED",
+	}
+	IncedentStatus_value = map[string]int32{
+		"INCEDENT_STATUS_UNKNOWN": 0,
+		"IN_BUFFER":               1,
+		"IN_PROCESSING":           2,
+		"PROCESSED":               3,
+		"REJECTED":                4,
+	}
+)
+
+func (x IncedentStatus) Enum() *IncedentStatus {
+	p := new(IncedentStatus)
+	*p = x
+	return p
+}
+
+func (x IncedentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IncedentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_messages_incedent_incedent_proto_enumTypes[0].Descriptor()
+}
+
+func (IncedentStatus) Type() protoreflect.EnumType {
+	return &file_messages_incedent_incedent_proto_enumTypes[0]
+}
+
+func (x IncedentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IncedentStatus.Descriptor instead.
+func (IncedentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_messages_incedent_incedent_proto_rawDescGZIP(), []int{0}
+}
+
 type NewIncedentReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -30,6 +85,10 @@ type NewIncedentReq struct {
 	Id       uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Time     *timestamp.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
 	Priority uint64               `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Payload  *Payload             `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	Labels   map[string]string    `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // free-form metadata
+	Source   string               `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`                                                                                         // id of the producer
+	Category string               `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
 }
 
 func (x *NewIncedentReq) Reset() {
@@ -83,6 +142,87 @@ func (x *NewIncedentReq) GetPriority() uint64 {
 	return 0
 }
 
+func (x *NewIncedentReq) GetPayload() *Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *NewIncedentReq) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *NewIncedentReq) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *NewIncedentReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type Payload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data        []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+}
+
+func (x *Payload) Reset() {
+	*x = Payload{}
+	mi := &file_messages_incedent_incedent_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payload) ProtoMessage() {}
+
+func (x *Payload) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_incedent_incedent_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payload.ProtoReflect.Descriptor instead.
+func (*Payload) Descriptor() ([]byte, []int) {
+	return file_messages_incedent_incedent_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Payload) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Payload) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
 type NewIncedentResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -93,7 +233,7 @@ type NewIncedentResp struct {
 
 func (x *NewIncedentResp) Reset() {
 	*x = NewIncedentResp{}
-	mi := &file_messages_incedent_incedent_proto_msgTypes[1]
+	mi := &file_messages_incedent_incedent_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -105,7 +245,7 @@ func (x *NewIncedentResp) String() string {
 func (*NewIncedentResp) ProtoMessage() {}
 
 func (x *NewIncedentResp) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_incedent_incedent_proto_msgTypes[1]
+	mi := &file_messages_incedent_incedent_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -118,7 +258,7 @@ func (x *NewIncedentResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewIncedentResp.ProtoReflect.Descriptor instead.
 func (*NewIncedentResp) Descriptor() ([]byte, []int) {
-	return file_messages_incedent_incedent_proto_rawDescGZIP(), []int{1}
+	return file_messages_incedent_incedent_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *NewIncedentResp) GetResult() *common.Result {
@@ -128,6 +268,157 @@ func (x *NewIncedentResp) GetResult() *common.Result {
 	return nil
 }
 
+type SubmitIncedentResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *common.Result `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Ticket string         `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"` // used to query status of the incedent
+}
+
+func (x *SubmitIncedentResp) Reset() {
+	*x = SubmitIncedentResp{}
+	mi := &file_messages_incedent_incedent_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitIncedentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitIncedentResp) ProtoMessage() {}
+
+func (x *SubmitIncedentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_incedent_incedent_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitIncedentResp.ProtoReflect.Descriptor instead.
+func (*SubmitIncedentResp) Descriptor() ([]byte, []int) {
+	return file_messages_incedent_incedent_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubmitIncedentResp) GetResult() *common.Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *SubmitIncedentResp) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+type IncedentStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (x *IncedentStatusReq) Reset() {
+	*x = IncedentStatusReq{}
+	mi := &file_messages_incedent_incedent_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncedentStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncedentStatusReq) ProtoMessage() {}
+
+func (x *IncedentStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_incedent_incedent_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncedentStatusReq.ProtoReflect.Descriptor instead.
+func (*IncedentStatusReq) Descriptor() ([]byte, []int) {
+	return file_messages_incedent_incedent_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *IncedentStatusReq) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+type IncedentStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *common.Result `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Status IncedentStatus `protobuf:"varint,2,opt,name=status,proto3,enum=incedent.IncedentStatus" json:"status,omitempty"`
+}
+
+func (x *IncedentStatusResp) Reset() {
+	*x = IncedentStatusResp{}
+	mi := &file_messages_incedent_incedent_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncedentStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncedentStatusResp) ProtoMessage() {}
+
+func (x *IncedentStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_incedent_incedent_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncedentStatusResp.ProtoReflect.Descriptor instead.
+func (*IncedentStatusResp) Descriptor() ([]byte, []int) {
+	return file_messages_incedent_incedent_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *IncedentStatusResp) GetResult() *common.Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *IncedentStatusResp) GetStatus() IncedentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IncedentStatus_INCEDENT_STATUS_UNKNOWN
+}
+
 var File_messages_incedent_incedent_proto protoreflect.FileDescriptor
 
 var file_messages_incedent_incedent_proto_rawDesc = []byte{
@@ -137,22 +428,62 @@ var file_messages_incedent_incedent_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x6d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6c, 0x0a, 0x0e, 0x4e, 0x65,
-	0x77, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x39, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x49,
-	0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x50, 0x6f, 0x6e, 0x6f, 0x6d, 0x61, 0x72, 0x65, 0x76, 0x41, 0x6c, 0x65, 0x78, 0x78,
-	0x61, 0x6e, 0x64, 0x65, 0x72, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x69, 0x6e,
-	0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x02, 0x0a, 0x0e, 0x4e,
+	0x65, 0x77, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x63,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x2e, 0x4e, 0x65, 0x77, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x40, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x39, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x49, 0x6e, 0x63, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x54, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x2b, 0x0a, 0x11, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x22, 0x6e, 0x0a, 0x12, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x69, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x63,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x2a, 0x6c, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x43, 0x45, 0x44, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10,
+	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49,
+	0x4e, 0x47, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10,
+	0x04, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x50, 0x6f, 0x6e, 0x6f, 0x6d, 0x61, 0x72, 0x65, 0x76, 0x41, 0x6c, 0x65, 0x78, 0x78, 0x61, 0x6e,
+	0x64, 0x65, 0x72, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x69, 0x6e, 0x63, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -167,21 +498,33 @@ func file_messages_incedent_incedent_proto_rawDescGZIP() []byte {
 	return file_messages_incedent_incedent_proto_rawDescData
 }
 
-var file_messages_incedent_incedent_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_messages_incedent_incedent_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_messages_incedent_incedent_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_messages_incedent_incedent_proto_goTypes = []any{
-	(*NewIncedentReq)(nil),      // 0: incedent.NewIncedentReq
-	(*NewIncedentResp)(nil),     // 1: incedent.NewIncedentResp
-	(*timestamp.Timestamp)(nil), // 2: google.protobuf.Timestamp
-	(*common.Result)(nil),       // 3: common.Result
+	(IncedentStatus)(0),         // 0: incedent.IncedentStatus
+	(*NewIncedentReq)(nil),      // 1: incedent.NewIncedentReq
+	(*Payload)(nil),             // 2: incedent.Payload
+	(*NewIncedentResp)(nil),     // 3: incedent.NewIncedentResp
+	(*SubmitIncedentResp)(nil),  // 4: incedent.SubmitIncedentResp
+	(*IncedentStatusReq)(nil),   // 5: incedent.IncedentStatusReq
+	(*IncedentStatusResp)(nil),  // 6: incedent.IncedentStatusResp
+	nil,                         // 7: incedent.NewIncedentReq.LabelsEntry
+	(*timestamp.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*common.Result)(nil),       // 9: common.Result
 }
 var file_messages_incedent_incedent_proto_depIdxs = []int32{
-	2, // 0: incedent.NewIncedentReq.time:type_name -> google.protobuf.Timestamp
-	3, // 1: incedent.NewIncedentResp.result:type_name -> common.Result
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	8, // 0: incedent.NewIncedentReq.time:type_name -> google.protobuf.Timestamp
+	2, // 1: incedent.NewIncedentReq.payload:type_name -> incedent.Payload
+	7, // 2: incedent.NewIncedentReq.labels:type_name -> incedent.NewIncedentReq.LabelsEntry
+	9, // 3: incedent.NewIncedentResp.result:type_name -> common.Result
+	9, // 4: incedent.SubmitIncedentResp.result:type_name -> common.Result
+	9, // 5: incedent.IncedentStatusResp.result:type_name -> common.Result
+	0, // 6: incedent.IncedentStatusResp.status:type_name -> incedent.IncedentStatus
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_messages_incedent_incedent_proto_init() }
@@ -194,13 +537,14 @@ func file_messages_incedent_incedent_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_messages_incedent_incedent_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_messages_incedent_incedent_proto_goTypes,
 		DependencyIndexes: file_messages_incedent_incedent_proto_depIdxs,
+		EnumInfos:         file_messages_incedent_incedent_proto_enumTypes,
 		MessageInfos:      file_messages_incedent_incedent_proto_msgTypes,
 	}.Build()
 	File_messages_incedent_incedent_proto = out.File
@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v3.12.4
+// source: messages/journal/journal.proto
+
+package journal
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNKNOWN EventType = 0
+	EventType_ARRIVED            EventType = 1
+	EventType_BUFFERED           EventType = 2
+	EventType_EVICTED            EventType = 3
+	EventType_STARTED            EventType = 4
+	EventType_ATTEMPT_FAILED     EventType = 5
+	EventType_FINISHED           EventType = 6
+	EventType_REJECTED           EventType = 7
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNKNOWN",
+		1: "ARRIVED",
+		2: "BUFFERED",
+		3: "EVICTED",
+		4: "STARTED",
+		5: "ATTEMPT_FAILED",
+		6: "FINISHED",
+		7: "REJECTED",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNKNOWN": 0,
+		"ARRIVED":            1,
+		"BUFFERED":           2,
+		"EVICTED":            3,
+		"STARTED":            4,
+		"ATTEMPT_FAILED":     5,
+		"FINISHED":           6,
+		"REJECTED":           7,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_messages_journal_journal_proto_enumTypes[0].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_messages_journal_journal_proto_enumTypes[0]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_messages_journal_journal_proto_rawDescGZIP(), []int{0}
+}
+
+type WatchJournalReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From uint64 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"` // first sequence number, events which aren't kept anymore are skipped
+}
+
+func (x *WatchJournalReq) Reset() {
+	*x = WatchJournalReq{}
+	mi := &file_messages_journal_journal_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchJournalReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchJournalReq) ProtoMessage() {}
+
+func (x *WatchJournalReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_journal_journal_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchJournalReq.ProtoReflect.Descriptor instead.
+func (*WatchJournalReq) Descriptor() ([]byte, []int) {
+	return file_messages_journal_journal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchJournalReq) GetFrom() uint64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+type Incedent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Priority uint64               `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Time     *timestamp.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *Incedent) Reset() {
+	*x = Incedent{}
+	mi := &file_messages_journal_journal_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Incedent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Incedent) ProtoMessage() {}
+
+func (x *Incedent) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_journal_journal_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Incedent.ProtoReflect.Descriptor instead.
+func (*Incedent) Descriptor() ([]byte, []int) {
+	return file_messages_journal_journal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Incedent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Incedent) GetPriority() uint64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Incedent) GetTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type ProcessorState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Incedents []*Incedent `protobuf:"bytes,2,rep,name=incedents,proto3" json:"incedents,omitempty"`
+}
+
+func (x *ProcessorState) Reset() {
+	*x = ProcessorState{}
+	mi := &file_messages_journal_journal_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessorState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessorState) ProtoMessage() {}
+
+func (x *ProcessorState) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_journal_journal_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessorState.ProtoReflect.Descriptor instead.
+func (*ProcessorState) Descriptor() ([]byte, []int) {
+	return file_messages_journal_journal_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProcessorState) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProcessorState) GetIncedents() []*Incedent {
+	if x != nil {
+		return x.Incedents
+	}
+	return nil
+}
+
+type JournalEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq         uint64               `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Time        *timestamp.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Type        EventType            `protobuf:"varint,3,opt,name=type,proto3,enum=journal.EventType" json:"type,omitempty"`
+	Incedent    *Incedent            `protobuf:"bytes,4,opt,name=incedent,proto3" json:"incedent,omitempty"`
+	ProcessorId uint64               `protobuf:"varint,5,opt,name=processor_id,json=processorId,proto3" json:"processor_id,omitempty"` // set for started, attempt failed and finished events
+	Buffer      []*Incedent          `protobuf:"bytes,6,rep,name=buffer,proto3" json:"buffer,omitempty"`                               // state after the event
+	Processors  []*ProcessorState    `protobuf:"bytes,7,rep,name=processors,proto3" json:"processors,omitempty"`
+}
+
+func (x *JournalEvent) Reset() {
+	*x = JournalEvent{}
+	mi := &file_messages_journal_journal_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalEvent) ProtoMessage() {}
+
+func (x *JournalEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_journal_journal_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalEvent.ProtoReflect.Descriptor instead.
+func (*JournalEvent) Descriptor() ([]byte, []int) {
+	return file_messages_journal_journal_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JournalEvent) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *JournalEvent) GetTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *JournalEvent) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNKNOWN
+}
+
+func (x *JournalEvent) GetIncedent() *Incedent {
+	if x != nil {
+		return x.Incedent
+	}
+	return nil
+}
+
+func (x *JournalEvent) GetProcessorId() uint64 {
+	if x != nil {
+		return x.ProcessorId
+	}
+	return 0
+}
+
+func (x *JournalEvent) GetBuffer() []*Incedent {
+	if x != nil {
+		return x.Buffer
+	}
+	return nil
+}
+
+func (x *JournalEvent) GetProcessors() []*ProcessorState {
+	if x != nil {
+		return x.Processors
+	}
+	return nil
+}
+
+var File_messages_journal_journal_proto protoreflect.FileDescriptor
+
+var file_messages_journal_journal_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6c, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x25, 0x0a, 0x0f, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x22, 0x66, 0x0a, 0x08, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x09, 0x69,
+	0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xae, 0x02, 0x0a,
+	0x0c, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12,
+	0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x69, 0x6e,
+	0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x62, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x62, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x2a, 0x88, 0x01,
+	0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x52, 0x52, 0x49, 0x56, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x0c, 0x0a, 0x08, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b,
+	0x0a, 0x07, 0x45, 0x56, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x54, 0x54, 0x45,
+	0x4d, 0x50, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08,
+	0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x07, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x50, 0x6f, 0x6e, 0x6f, 0x6d, 0x61, 0x72, 0x65, 0x76,
+	0x41, 0x6c, 0x65, 0x78, 0x78, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x69,
+	0x6e, 0x67, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_messages_journal_journal_proto_rawDescOnce sync.Once
+	file_messages_journal_journal_proto_rawDescData = file_messages_journal_journal_proto_rawDesc
+)
+
+func file_messages_journal_journal_proto_rawDescGZIP() []byte {
+	file_messages_journal_journal_proto_rawDescOnce.Do(func() {
+		file_messages_journal_journal_proto_rawDescData = protoimpl.X.CompressGZIP(file_messages_journal_journal_proto_rawDescData)
+	})
+	return file_messages_journal_journal_proto_rawDescData
+}
+
+var file_messages_journal_journal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_messages_journal_journal_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_messages_journal_journal_proto_goTypes = []any{
+	(EventType)(0),              // 0: journal.EventType
+	(*WatchJournalReq)(nil),     // 1: journal.WatchJournalReq
+	(*Incedent)(nil),            // 2: journal.Incedent
+	(*ProcessorState)(nil),      // 3: journal.ProcessorState
+	(*JournalEvent)(nil),        // 4: journal.JournalEvent
+	(*timestamp.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_messages_journal_journal_proto_depIdxs = []int32{
+	5, // 0: journal.Incedent.time:type_name -> google.protobuf.Timestamp
+	2, // 1: journal.ProcessorState.incedents:type_name -> journal.Incedent
+	5, // 2: journal.JournalEvent.time:type_name -> google.protobuf.Timestamp
+	0, // 3: journal.JournalEvent.type:type_name -> journal.EventType
+	2, // 4: journal.JournalEvent.incedent:type_name -> journal.Incedent
+	2, // 5: journal.JournalEvent.buffer:type_name -> journal.Incedent
+	3, // 6: journal.JournalEvent.processors:type_name -> journal.ProcessorState
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_messages_journal_journal_proto_init() }
+func file_messages_journal_journal_proto_init() {
+	if File_messages_journal_journal_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_messages_journal_journal_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_messages_journal_journal_proto_goTypes,
+		DependencyIndexes: file_messages_journal_journal_proto_depIdxs,
+		EnumInfos:         file_messages_journal_journal_proto_enumTypes,
+		MessageInfos:      file_messages_journal_journal_proto_msgTypes,
+	}.Build()
+	File_messages_journal_journal_proto = out.File
+	file_messages_journal_journal_proto_rawDesc = nil
+	file_messages_journal_journal_proto_goTypes = nil
+	file_messages_journal_journal_proto_depIdxs = nil
+}
@@ -26,8 +26,10 @@ type ProcessorRegisterReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"` // host of the processor server
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Host     string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`          // host of the processor server
+	Weight   uint32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`     // share of incedents for weighted selection, 0 means 1
+	Capacity uint32 `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"` // number of incedents processed in parallel, 0 means 1
 }
 
 func (x *ProcessorRegisterReq) Reset() {
@@ -74,6 +76,20 @@ func (x *ProcessorRegisterReq) GetHost() string {
 	return ""
 }
 
+func (x *ProcessorRegisterReq) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ProcessorRegisterReq) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
 type ProcessorRegisterResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -119,6 +135,194 @@ func (x *ProcessorRegisterResp) GetResult() *common.Result {
 	return nil
 }
 
+type HeartbeatReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *HeartbeatReq) Reset() {
+	*x = HeartbeatReq{}
+	mi := &file_messages_registration_registration_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatReq) ProtoMessage() {}
+
+func (x *HeartbeatReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_registration_registration_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatReq.ProtoReflect.Descriptor instead.
+func (*HeartbeatReq) Descriptor() ([]byte, []int) {
+	return file_messages_registration_registration_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HeartbeatReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type HeartbeatResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result  *common.Result `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Retired bool           `protobuf:"varint,2,opt,name=retired,proto3" json:"retired,omitempty"` // processor was deregistered by admin and mustn't register again
+}
+
+func (x *HeartbeatResp) Reset() {
+	*x = HeartbeatResp{}
+	mi := &file_messages_registration_registration_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResp) ProtoMessage() {}
+
+func (x *HeartbeatResp) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_registration_registration_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResp.ProtoReflect.Descriptor instead.
+func (*HeartbeatResp) Descriptor() ([]byte, []int) {
+	return file_messages_registration_registration_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HeartbeatResp) GetResult() *common.Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *HeartbeatResp) GetRetired() bool {
+	if x != nil {
+		return x.Retired
+	}
+	return false
+}
+
+type ProcessorDeregisterReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ProcessorDeregisterReq) Reset() {
+	*x = ProcessorDeregisterReq{}
+	mi := &file_messages_registration_registration_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessorDeregisterReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessorDeregisterReq) ProtoMessage() {}
+
+func (x *ProcessorDeregisterReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_registration_registration_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessorDeregisterReq.ProtoReflect.Descriptor instead.
+func (*ProcessorDeregisterReq) Descriptor() ([]byte, []int) {
+	return file_messages_registration_registration_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProcessorDeregisterReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ProcessorDeregisterResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *common.Result `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ProcessorDeregisterResp) Reset() {
+	*x = ProcessorDeregisterResp{}
+	mi := &file_messages_registration_registration_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessorDeregisterResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessorDeregisterResp) ProtoMessage() {}
+
+func (x *ProcessorDeregisterResp) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_registration_registration_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessorDeregisterResp.ProtoReflect.Descriptor instead.
+func (*ProcessorDeregisterResp) Descriptor() ([]byte, []int) {
+	return file_messages_registration_registration_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProcessorDeregisterResp) GetResult() *common.Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
 var File_messages_registration_registration_proto protoreflect.FileDescriptor
 
 var file_messages_registration_registration_proto_rawDesc = []byte{
@@ -127,20 +331,37 @@ var file_messages_registration_registration_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x72, 0x65, 0x67, 0x69,
 	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3a, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6e, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
 	0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
 	0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73,
-	0x74, 0x22, 0x3f, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x50, 0x6f, 0x6e, 0x6f, 0x6d, 0x61, 0x72, 0x65, 0x76, 0x41, 0x6c, 0x65, 0x78, 0x78, 0x61,
-	0x6e, 0x64, 0x65, 0x72, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0x3f, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1e, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x22, 0x28, 0x0a, 0x16, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x50, 0x6f, 0x6e, 0x6f, 0x6d, 0x61, 0x72, 0x65, 0x76, 0x41, 0x6c,
+	0x65, 0x78, 0x78, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67,
+	0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -155,19 +376,25 @@ func file_messages_registration_registration_proto_rawDescGZIP() []byte {
 	return file_messages_registration_registration_proto_rawDescData
 }
 
-var file_messages_registration_registration_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_messages_registration_registration_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_messages_registration_registration_proto_goTypes = []any{
-	(*ProcessorRegisterReq)(nil),  // 0: registration.ProcessorRegisterReq
-	(*ProcessorRegisterResp)(nil), // 1: registration.ProcessorRegisterResp
-	(*common.Result)(nil),         // 2: common.Result
+	(*ProcessorRegisterReq)(nil),    // 0: registration.ProcessorRegisterReq
+	(*ProcessorRegisterResp)(nil),   // 1: registration.ProcessorRegisterResp
+	(*HeartbeatReq)(nil),            // 2: registration.HeartbeatReq
+	(*HeartbeatResp)(nil),           // 3: registration.HeartbeatResp
+	(*ProcessorDeregisterReq)(nil),  // 4: registration.ProcessorDeregisterReq
+	(*ProcessorDeregisterResp)(nil), // 5: registration.ProcessorDeregisterResp
+	(*common.Result)(nil),           // 6: common.Result
 }
 var file_messages_registration_registration_proto_depIdxs = []int32{
-	2, // 0: registration.ProcessorRegisterResp.result:type_name -> common.Result
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6, // 0: registration.ProcessorRegisterResp.result:type_name -> common.Result
+	6, // 1: registration.HeartbeatResp.result:type_name -> common.Result
+	6, // 2: registration.ProcessorDeregisterResp.result:type_name -> common.Result
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_messages_registration_registration_proto_init() }
@@ -181,7 +408,7 @@ func file_messages_registration_registration_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_messages_registration_registration_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,848 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v3.12.4
+// source: messages/statistics/statistics.proto
+
+package statistics
+
+import (
+	common "github.com/PonomarevAlexxander/queuing-system/messages/common"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ResetStatisticsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResetStatisticsReq) Reset() {
+	*x = ResetStatisticsReq{}
+	mi := &file_messages_statistics_statistics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetStatisticsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetStatisticsReq) ProtoMessage() {}
+
+func (x *ResetStatisticsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_statistics_statistics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetStatisticsReq.ProtoReflect.Descriptor instead.
+func (*ResetStatisticsReq) Descriptor() ([]byte, []int) {
+	return file_messages_statistics_statistics_proto_rawDescGZIP(), []int{0}
+}
+
+type ResetStatisticsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *common.Result `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ResetStatisticsResp) Reset() {
+	*x = ResetStatisticsResp{}
+	mi := &file_messages_statistics_statistics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetStatisticsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetStatisticsResp) ProtoMessage() {}
+
+func (x *ResetStatisticsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_statistics_statistics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetStatisticsResp.ProtoReflect.Descriptor instead.
+func (*ResetStatisticsResp) Descriptor() ([]byte, []int) {
+	return file_messages_statistics_statistics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResetStatisticsResp) GetResult() *common.Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type StatisticsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StatisticsReq) Reset() {
+	*x = StatisticsReq{}
+	mi := &file_messages_statistics_statistics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatisticsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatisticsReq) ProtoMessage() {}
+
+func (x *StatisticsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_statistics_statistics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatisticsReq.ProtoReflect.Descriptor instead.
+func (*StatisticsReq) Descriptor() ([]byte, []int) {
+	return file_messages_statistics_statistics_proto_rawDescGZIP(), []int{2}
+}
+
+type WatchStatisticsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interval *duration.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"` // period of updates, 0 means 1s
+}
+
+func (x *WatchStatisticsReq) Reset() {
+	*x = WatchStatisticsReq{}
+	mi := &file_messages_statistics_statistics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchStatisticsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStatisticsReq) ProtoMessage() {}
+
+func (x *WatchStatisticsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_statistics_statistics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStatisticsReq.ProtoReflect.Descriptor instead.
+func (*WatchStatisticsReq) Descriptor() ([]byte, []int) {
+	return file_messages_statistics_statistics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WatchStatisticsReq) GetInterval() *duration.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+type TimeStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mean     *duration.Duration `protobuf:"bytes,1,opt,name=mean,proto3" json:"mean,omitempty"`
+	Variance float64            `protobuf:"fixed64,2,opt,name=variance,proto3" json:"variance,omitempty"` // in seconds^2
+}
+
+func (x *TimeStats) Reset() {
+	*x = TimeStats{}
+	mi := &file_messages_statistics_statistics_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeStats) ProtoMessage() {}
+
+func (x *TimeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_statistics_statistics_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeStats.ProtoReflect.Descriptor instead.
+func (*TimeStats) Descriptor() ([]byte, []int) {
+	return file_messages_statistics_statistics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TimeStats) GetMean() *duration.Duration {
+	if x != nil {
+		return x.Mean
+	}
+	return nil
+}
+
+func (x *TimeStats) GetVariance() float64 {
+	if x != nil {
+		return x.Variance
+	}
+	return 0
+}
+
+type ConfidenceInterval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mean      float64 `protobuf:"fixed64,1,opt,name=mean,proto3" json:"mean,omitempty"`
+	HalfWidth float64 `protobuf:"fixed64,2,opt,name=half_width,json=halfWidth,proto3" json:"half_width,omitempty"`
+	Samples   uint64  `protobuf:"varint,3,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *ConfidenceInterval) Reset() {
+	*x = ConfidenceInterval{}
+	mi := &file_messages_statistics_statistics_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfidenceInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfidenceInterval) ProtoMessage() {}
+
+func (x *ConfidenceInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_statistics_statistics_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfidenceInterval.ProtoReflect.Descriptor instead.
+func (*ConfidenceInterval) Descriptor() ([]byte, []int) {
+	return file_messages_statistics_statistics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConfidenceInterval) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *ConfidenceInterval) GetHalfWidth() float64 {
+	if x != nil {
+		return x.HalfWidth
+	}
+	return 0
+}
+
+func (x *ConfidenceInterval) GetSamples() uint64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+type PriorityStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Priority             uint64              `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Total                uint64              `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Processed            uint64              `protobuf:"varint,3,opt,name=processed,proto3" json:"processed,omitempty"`
+	Rejected             uint64              `protobuf:"varint,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Evicted              uint64              `protobuf:"varint,5,opt,name=evicted,proto3" json:"evicted,omitempty"`
+	Retried              uint64              `protobuf:"varint,6,opt,name=retried,proto3" json:"retried,omitempty"`
+	InProgress           uint64              `protobuf:"varint,7,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty"`
+	PRejected            float64             `protobuf:"fixed64,8,opt,name=p_rejected,json=pRejected,proto3" json:"p_rejected,omitempty"`
+	TimeInSystem         *duration.Duration  `protobuf:"bytes,9,opt,name=time_in_system,json=timeInSystem,proto3" json:"time_in_system,omitempty"`
+	TimeInBuffer         *TimeStats          `protobuf:"bytes,10,opt,name=time_in_buffer,json=timeInBuffer,proto3" json:"time_in_buffer,omitempty"`
+	TimeInProcessing     *TimeStats          `protobuf:"bytes,11,opt,name=time_in_processing,json=timeInProcessing,proto3" json:"time_in_processing,omitempty"`
+	PRejectedInterval    *ConfidenceInterval `protobuf:"bytes,12,opt,name=p_rejected_interval,json=pRejectedInterval,proto3" json:"p_rejected_interval,omitempty"`
+	TimeInSystemInterval *ConfidenceInterval `protobuf:"bytes,13,opt,name=time_in_system_interval,json=timeInSystemInterval,proto3" json:"time_in_system_interval,omitempty"` // in seconds
+	RequiredIncedents    uint64              `protobuf:"varint,14,opt,name=required_incedents,json=requiredIncedents,proto3" json:"required_incedents,omitempty"`             // to estimate p_rejected with configured accuracy
+}
+
+func (x *PriorityStatistics) Reset() {
+	*x = PriorityStatistics{}
+	mi := &file_messages_statistics_statistics_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriorityStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriorityStatistics) ProtoMessage() {}
+
+func (x *PriorityStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_statistics_statistics_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriorityStatistics.ProtoReflect.Descriptor instead.
+func (*PriorityStatistics) Descriptor() ([]byte, []int) {
+	return file_messages_statistics_statistics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PriorityStatistics) GetPriority() uint64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *PriorityStatistics) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PriorityStatistics) GetProcessed() uint64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *PriorityStatistics) GetRejected() uint64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *PriorityStatistics) GetEvicted() uint64 {
+	if x != nil {
+		return x.Evicted
+	}
+	return 0
+}
+
+func (x *PriorityStatistics) GetRetried() uint64 {
+	if x != nil {
+		return x.Retried
+	}
+	return 0
+}
+
+func (x *PriorityStatistics) GetInProgress() uint64 {
+	if x != nil {
+		return x.InProgress
+	}
+	return 0
+}
+
+func (x *PriorityStatistics) GetPRejected() float64 {
+	if x != nil {
+		return x.PRejected
+	}
+	return 0
+}
+
+func (x *PriorityStatistics) GetTimeInSystem() *duration.Duration {
+	if x != nil {
+		return x.TimeInSystem
+	}
+	return nil
+}
+
+func (x *PriorityStatistics) GetTimeInBuffer() *TimeStats {
+	if x != nil {
+		return x.TimeInBuffer
+	}
+	return nil
+}
+
+func (x *PriorityStatistics) GetTimeInProcessing() *TimeStats {
+	if x != nil {
+		return x.TimeInProcessing
+	}
+	return nil
+}
+
+func (x *PriorityStatistics) GetPRejectedInterval() *ConfidenceInterval {
+	if x != nil {
+		return x.PRejectedInterval
+	}
+	return nil
+}
+
+func (x *PriorityStatistics) GetTimeInSystemInterval() *ConfidenceInterval {
+	if x != nil {
+		return x.TimeInSystemInterval
+	}
+	return nil
+}
+
+func (x *PriorityStatistics) GetRequiredIncedents() uint64 {
+	if x != nil {
+		return x.RequiredIncedents
+	}
+	return 0
+}
+
+type ProcessorStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Capacity       uint32               `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	RegisteredAt   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+	EndAt          *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Deregistered   bool                 `protobuf:"varint,5,opt,name=deregistered,proto3" json:"deregistered,omitempty"`
+	LeaseExpired   bool                 `protobuf:"varint,6,opt,name=lease_expired,json=leaseExpired,proto3" json:"lease_expired,omitempty"`
+	Uptime         *duration.Duration   `protobuf:"bytes,7,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	InWork         *duration.Duration   `protobuf:"bytes,8,opt,name=in_work,json=inWork,proto3" json:"in_work,omitempty"`
+	FailedAttempts uint64               `protobuf:"varint,9,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	Utilization    float64              `protobuf:"fixed64,10,opt,name=utilization,proto3" json:"utilization,omitempty"` // per slot
+}
+
+func (x *ProcessorStatistics) Reset() {
+	*x = ProcessorStatistics{}
+	mi := &file_messages_statistics_statistics_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessorStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessorStatistics) ProtoMessage() {}
+
+func (x *ProcessorStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_statistics_statistics_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessorStatistics.ProtoReflect.Descriptor instead.
+func (*ProcessorStatistics) Descriptor() ([]byte, []int) {
+	return file_messages_statistics_statistics_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProcessorStatistics) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProcessorStatistics) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *ProcessorStatistics) GetRegisteredAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.RegisteredAt
+	}
+	return nil
+}
+
+func (x *ProcessorStatistics) GetEndAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *ProcessorStatistics) GetDeregistered() bool {
+	if x != nil {
+		return x.Deregistered
+	}
+	return false
+}
+
+func (x *ProcessorStatistics) GetLeaseExpired() bool {
+	if x != nil {
+		return x.LeaseExpired
+	}
+	return false
+}
+
+func (x *ProcessorStatistics) GetUptime() *duration.Duration {
+	if x != nil {
+		return x.Uptime
+	}
+	return nil
+}
+
+func (x *ProcessorStatistics) GetInWork() *duration.Duration {
+	if x != nil {
+		return x.InWork
+	}
+	return nil
+}
+
+func (x *ProcessorStatistics) GetFailedAttempts() uint64 {
+	if x != nil {
+		return x.FailedAttempts
+	}
+	return 0
+}
+
+func (x *ProcessorStatistics) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+type StatisticsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result     *common.Result         `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Time       *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Since      *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"` // start of statistics, moves on warm-up end and reset
+	Priorities []*PriorityStatistics  `protobuf:"bytes,4,rep,name=priorities,proto3" json:"priorities,omitempty"`
+	Processors []*ProcessorStatistics `protobuf:"bytes,5,rep,name=processors,proto3" json:"processors,omitempty"`
+}
+
+func (x *StatisticsResp) Reset() {
+	*x = StatisticsResp{}
+	mi := &file_messages_statistics_statistics_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatisticsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatisticsResp) ProtoMessage() {}
+
+func (x *StatisticsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_statistics_statistics_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatisticsResp.ProtoReflect.Descriptor instead.
+func (*StatisticsResp) Descriptor() ([]byte, []int) {
+	return file_messages_statistics_statistics_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StatisticsResp) GetResult() *common.Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *StatisticsResp) GetTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *StatisticsResp) GetSince() *timestamp.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *StatisticsResp) GetPriorities() []*PriorityStatistics {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+func (x *StatisticsResp) GetProcessors() []*ProcessorStatistics {
+	if x != nil {
+		return x.Processors
+	}
+	return nil
+}
+
+var File_messages_statistics_statistics_proto protoreflect.FileDescriptor
+
+var file_messages_statistics_statistics_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x22, 0x3d, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x22, 0x4b, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x22, 0x56, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x2d, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x61, 0x0a, 0x12, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x6c, 0x66, 0x5f, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x68, 0x61, 0x6c, 0x66, 0x57,
+	0x69, 0x64, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x8d,
+	0x05, 0x0a, 0x12, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x6e, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x5f, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e,
+	0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x3b, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69,
+	0x6e, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x42, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x4e, 0x0a, 0x13, 0x70, 0x5f, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x11, 0x70, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x55, 0x0a, 0x17, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x49,
+	0x6e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x2d, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x63, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xb0,
+	0x03, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x65,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12,
+	0x31, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x69, 0x6e, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x9b, 0x02, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x3e,
+	0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3f,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x42,
+	0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x50, 0x6f,
+	0x6e, 0x6f, 0x6d, 0x61, 0x72, 0x65, 0x76, 0x41, 0x6c, 0x65, 0x78, 0x78, 0x61, 0x6e, 0x64, 0x65,
+	0x72, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_messages_statistics_statistics_proto_rawDescOnce sync.Once
+	file_messages_statistics_statistics_proto_rawDescData = file_messages_statistics_statistics_proto_rawDesc
+)
+
+func file_messages_statistics_statistics_proto_rawDescGZIP() []byte {
+	file_messages_statistics_statistics_proto_rawDescOnce.Do(func() {
+		file_messages_statistics_statistics_proto_rawDescData = protoimpl.X.CompressGZIP(file_messages_statistics_statistics_proto_rawDescData)
+	})
+	return file_messages_statistics_statistics_proto_rawDescData
+}
+
+var file_messages_statistics_statistics_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_messages_statistics_statistics_proto_goTypes = []any{
+	(*ResetStatisticsReq)(nil),  // 0: statistics.ResetStatisticsReq
+	(*ResetStatisticsResp)(nil), // 1: statistics.ResetStatisticsResp
+	(*StatisticsReq)(nil),       // 2: statistics.StatisticsReq
+	(*WatchStatisticsReq)(nil),  // 3: statistics.WatchStatisticsReq
+	(*TimeStats)(nil),           // 4: statistics.TimeStats
+	(*ConfidenceInterval)(nil),  // 5: statistics.ConfidenceInterval
+	(*PriorityStatistics)(nil),  // 6: statistics.PriorityStatistics
+	(*ProcessorStatistics)(nil), // 7: statistics.ProcessorStatistics
+	(*StatisticsResp)(nil),      // 8: statistics.StatisticsResp
+	(*common.Result)(nil),       // 9: common.Result
+	(*duration.Duration)(nil),   // 10: google.protobuf.Duration
+	(*timestamp.Timestamp)(nil), // 11: google.protobuf.Timestamp
+}
+var file_messages_statistics_statistics_proto_depIdxs = []int32{
+	9,  // 0: statistics.ResetStatisticsResp.result:type_name -> common.Result
+	10, // 1: statistics.WatchStatisticsReq.interval:type_name -> google.protobuf.Duration
+	10, // 2: statistics.TimeStats.mean:type_name -> google.protobuf.Duration
+	10, // 3: statistics.PriorityStatistics.time_in_system:type_name -> google.protobuf.Duration
+	4,  // 4: statistics.PriorityStatistics.time_in_buffer:type_name -> statistics.TimeStats
+	4,  // 5: statistics.PriorityStatistics.time_in_processing:type_name -> statistics.TimeStats
+	5,  // 6: statistics.PriorityStatistics.p_rejected_interval:type_name -> statistics.ConfidenceInterval
+	5,  // 7: statistics.PriorityStatistics.time_in_system_interval:type_name -> statistics.ConfidenceInterval
+	11, // 8: statistics.ProcessorStatistics.registered_at:type_name -> google.protobuf.Timestamp
+	11, // 9: statistics.ProcessorStatistics.end_at:type_name -> google.protobuf.Timestamp
+	10, // 10: statistics.ProcessorStatistics.uptime:type_name -> google.protobuf.Duration
+	10, // 11: statistics.ProcessorStatistics.in_work:type_name -> google.protobuf.Duration
+	9,  // 12: statistics.StatisticsResp.result:type_name -> common.Result
+	11, // 13: statistics.StatisticsResp.time:type_name -> google.protobuf.Timestamp
+	11, // 14: statistics.StatisticsResp.since:type_name -> google.protobuf.Timestamp
+	6,  // 15: statistics.StatisticsResp.priorities:type_name -> statistics.PriorityStatistics
+	7,  // 16: statistics.StatisticsResp.processors:type_name -> statistics.ProcessorStatistics
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_messages_statistics_statistics_proto_init() }
+func file_messages_statistics_statistics_proto_init() {
+	if File_messages_statistics_statistics_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_messages_statistics_statistics_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_messages_statistics_statistics_proto_goTypes,
+		DependencyIndexes: file_messages_statistics_statistics_proto_depIdxs,
+		MessageInfos:      file_messages_statistics_statistics_proto_msgTypes,
+	}.Build()
+	File_messages_statistics_statistics_proto = out.File
+	file_messages_statistics_statistics_proto_rawDesc = nil
+	file_messages_statistics_statistics_proto_goTypes = nil
+	file_messages_statistics_statistics_proto_depIdxs = nil
+}
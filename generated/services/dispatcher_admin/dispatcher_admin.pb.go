@@ -0,0 +1,135 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v3.12.4
+// source: services/dispatcher_admin/dispatcher_admin.proto
+
+package dispatcher_admin
+
+import (
+	admin "github.com/PonomarevAlexxander/queuing-system/messages/admin"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_services_dispatcher_admin_dispatcher_admin_proto protoreflect.FileDescriptor
+
+var file_services_dispatcher_admin_dispatcher_admin_proto_rawDesc = []byte{
+	0x0a, 0x30, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x69, 0x73, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x10, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x1a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x32, 0xb4, 0x04, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x12, 0x50, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53,
+	0x65, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x45, 0x76, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x53, 0x65, 0x74, 0x45, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x12, 0x1e, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71,
+	0x1a, 0x1f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x18, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x72,
+	0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a,
+	0x19, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x13,
+	0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x12, 0x1d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x19, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x38, 0x0a,
+	0x09, 0x47, 0x65, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a,
+	0x14, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x49, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x50, 0x6f, 0x6e, 0x6f, 0x6d, 0x61, 0x72, 0x65, 0x76, 0x41,
+	0x6c, 0x65, 0x78, 0x78, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e,
+	0x67, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_services_dispatcher_admin_dispatcher_admin_proto_goTypes = []any{
+	(*admin.SetBufferCapacityReq)(nil),     // 0: admin.SetBufferCapacityReq
+	(*admin.SetEvictionPolicyReq)(nil),     // 1: admin.SetEvictionPolicyReq
+	(*admin.SetSelectionStrategyReq)(nil),  // 2: admin.SetSelectionStrategyReq
+	(*admin.DrainProcessorReq)(nil),        // 3: admin.DrainProcessorReq
+	(*admin.DeregisterProcessorReq)(nil),   // 4: admin.DeregisterProcessorReq
+	(*admin.ListProcessorsReq)(nil),        // 5: admin.ListProcessorsReq
+	(*admin.GetBufferReq)(nil),             // 6: admin.GetBufferReq
+	(*admin.SetBufferCapacityResp)(nil),    // 7: admin.SetBufferCapacityResp
+	(*admin.SetEvictionPolicyResp)(nil),    // 8: admin.SetEvictionPolicyResp
+	(*admin.SetSelectionStrategyResp)(nil), // 9: admin.SetSelectionStrategyResp
+	(*admin.DrainProcessorResp)(nil),       // 10: admin.DrainProcessorResp
+	(*admin.DeregisterProcessorResp)(nil),  // 11: admin.DeregisterProcessorResp
+	(*admin.ListProcessorsResp)(nil),       // 12: admin.ListProcessorsResp
+	(*admin.GetBufferResp)(nil),            // 13: admin.GetBufferResp
+}
+var file_services_dispatcher_admin_dispatcher_admin_proto_depIdxs = []int32{
+	0,  // 0: dispatcher_admin.DispatcherAdmin.SetBufferCapacity:input_type -> admin.SetBufferCapacityReq
+	1,  // 1: dispatcher_admin.DispatcherAdmin.SetEvictionPolicy:input_type -> admin.SetEvictionPolicyReq
+	2,  // 2: dispatcher_admin.DispatcherAdmin.SetSelectionStrategy:input_type -> admin.SetSelectionStrategyReq
+	3,  // 3: dispatcher_admin.DispatcherAdmin.DrainProcessor:input_type -> admin.DrainProcessorReq
+	4,  // 4: dispatcher_admin.DispatcherAdmin.DeregisterProcessor:input_type -> admin.DeregisterProcessorReq
+	5,  // 5: dispatcher_admin.DispatcherAdmin.ListProcessors:input_type -> admin.ListProcessorsReq
+	6,  // 6: dispatcher_admin.DispatcherAdmin.GetBuffer:input_type -> admin.GetBufferReq
+	7,  // 7: dispatcher_admin.DispatcherAdmin.SetBufferCapacity:output_type -> admin.SetBufferCapacityResp
+	8,  // 8: dispatcher_admin.DispatcherAdmin.SetEvictionPolicy:output_type -> admin.SetEvictionPolicyResp
+	9,  // 9: dispatcher_admin.DispatcherAdmin.SetSelectionStrategy:output_type -> admin.SetSelectionStrategyResp
+	10, // 10: dispatcher_admin.DispatcherAdmin.DrainProcessor:output_type -> admin.DrainProcessorResp
+	11, // 11: dispatcher_admin.DispatcherAdmin.DeregisterProcessor:output_type -> admin.DeregisterProcessorResp
+	12, // 12: dispatcher_admin.DispatcherAdmin.ListProcessors:output_type -> admin.ListProcessorsResp
+	13, // 13: dispatcher_admin.DispatcherAdmin.GetBuffer:output_type -> admin.GetBufferResp
+	7,  // [7:14] is the sub-list for method output_type
+	0,  // [0:7] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_services_dispatcher_admin_dispatcher_admin_proto_init() }
+func file_services_dispatcher_admin_dispatcher_admin_proto_init() {
+	if File_services_dispatcher_admin_dispatcher_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_services_dispatcher_admin_dispatcher_admin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_services_dispatcher_admin_dispatcher_admin_proto_goTypes,
+		DependencyIndexes: file_services_dispatcher_admin_dispatcher_admin_proto_depIdxs,
+	}.Build()
+	File_services_dispatcher_admin_dispatcher_admin_proto = out.File
+	file_services_dispatcher_admin_dispatcher_admin_proto_rawDesc = nil
+	file_services_dispatcher_admin_dispatcher_admin_proto_goTypes = nil
+	file_services_dispatcher_admin_dispatcher_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,354 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.12.4
+// source: services/dispatcher_admin/dispatcher_admin.proto
+
+package dispatcher_admin
+
+import (
+	context "context"
+	admin "github.com/PonomarevAlexxander/queuing-system/messages/admin"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DispatcherAdmin_SetBufferCapacity_FullMethodName    = "/dispatcher_admin.DispatcherAdmin/SetBufferCapacity"
+	DispatcherAdmin_SetEvictionPolicy_FullMethodName    = "/dispatcher_admin.DispatcherAdmin/SetEvictionPolicy"
+	DispatcherAdmin_SetSelectionStrategy_FullMethodName = "/dispatcher_admin.DispatcherAdmin/SetSelectionStrategy"
+	DispatcherAdmin_DrainProcessor_FullMethodName       = "/dispatcher_admin.DispatcherAdmin/DrainProcessor"
+	DispatcherAdmin_DeregisterProcessor_FullMethodName  = "/dispatcher_admin.DispatcherAdmin/DeregisterProcessor"
+	DispatcherAdmin_ListProcessors_FullMethodName       = "/dispatcher_admin.DispatcherAdmin/ListProcessors"
+	DispatcherAdmin_GetBuffer_FullMethodName            = "/dispatcher_admin.DispatcherAdmin/GetBuffer"
+)
+
+// DispatcherAdminClient is the client API for DispatcherAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DispatcherAdminClient interface {
+	SetBufferCapacity(ctx context.Context, in *admin.SetBufferCapacityReq, opts ...grpc.CallOption) (*admin.SetBufferCapacityResp, error)
+	SetEvictionPolicy(ctx context.Context, in *admin.SetEvictionPolicyReq, opts ...grpc.CallOption) (*admin.SetEvictionPolicyResp, error)
+	SetSelectionStrategy(ctx context.Context, in *admin.SetSelectionStrategyReq, opts ...grpc.CallOption) (*admin.SetSelectionStrategyResp, error)
+	// DrainProcessor stops sending incedents to processor, it is deregistered when in-flight ones are finished
+	DrainProcessor(ctx context.Context, in *admin.DrainProcessorReq, opts ...grpc.CallOption) (*admin.DrainProcessorResp, error)
+	// DeregisterProcessor removes processor right away, it isn't allowed to register again on heartbeat
+	DeregisterProcessor(ctx context.Context, in *admin.DeregisterProcessorReq, opts ...grpc.CallOption) (*admin.DeregisterProcessorResp, error)
+	ListProcessors(ctx context.Context, in *admin.ListProcessorsReq, opts ...grpc.CallOption) (*admin.ListProcessorsResp, error)
+	GetBuffer(ctx context.Context, in *admin.GetBufferReq, opts ...grpc.CallOption) (*admin.GetBufferResp, error)
+}
+
+type dispatcherAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDispatcherAdminClient(cc grpc.ClientConnInterface) DispatcherAdminClient {
+	return &dispatcherAdminClient{cc}
+}
+
+func (c *dispatcherAdminClient) SetBufferCapacity(ctx context.Context, in *admin.SetBufferCapacityReq, opts ...grpc.CallOption) (*admin.SetBufferCapacityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(admin.SetBufferCapacityResp)
+	err := c.cc.Invoke(ctx, DispatcherAdmin_SetBufferCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherAdminClient) SetEvictionPolicy(ctx context.Context, in *admin.SetEvictionPolicyReq, opts ...grpc.CallOption) (*admin.SetEvictionPolicyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(admin.SetEvictionPolicyResp)
+	err := c.cc.Invoke(ctx, DispatcherAdmin_SetEvictionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherAdminClient) SetSelectionStrategy(ctx context.Context, in *admin.SetSelectionStrategyReq, opts ...grpc.CallOption) (*admin.SetSelectionStrategyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(admin.SetSelectionStrategyResp)
+	err := c.cc.Invoke(ctx, DispatcherAdmin_SetSelectionStrategy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherAdminClient) DrainProcessor(ctx context.Context, in *admin.DrainProcessorReq, opts ...grpc.CallOption) (*admin.DrainProcessorResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(admin.DrainProcessorResp)
+	err := c.cc.Invoke(ctx, DispatcherAdmin_DrainProcessor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherAdminClient) DeregisterProcessor(ctx context.Context, in *admin.DeregisterProcessorReq, opts ...grpc.CallOption) (*admin.DeregisterProcessorResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(admin.DeregisterProcessorResp)
+	err := c.cc.Invoke(ctx, DispatcherAdmin_DeregisterProcessor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherAdminClient) ListProcessors(ctx context.Context, in *admin.ListProcessorsReq, opts ...grpc.CallOption) (*admin.ListProcessorsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(admin.ListProcessorsResp)
+	err := c.cc.Invoke(ctx, DispatcherAdmin_ListProcessors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherAdminClient) GetBuffer(ctx context.Context, in *admin.GetBufferReq, opts ...grpc.CallOption) (*admin.GetBufferResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(admin.GetBufferResp)
+	err := c.cc.Invoke(ctx, DispatcherAdmin_GetBuffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DispatcherAdminServer is the server API for DispatcherAdmin service.
+// All implementations must embed UnimplementedDispatcherAdminServer
+// for forward compatibility.
+type DispatcherAdminServer interface {
+	SetBufferCapacity(context.Context, *admin.SetBufferCapacityReq) (*admin.SetBufferCapacityResp, error)
+	SetEvictionPolicy(context.Context, *admin.SetEvictionPolicyReq) (*admin.SetEvictionPolicyResp, error)
+	SetSelectionStrategy(context.Context, *admin.SetSelectionStrategyReq) (*admin.SetSelectionStrategyResp, error)
+	// DrainProcessor stops sending incedents to processor, it is deregistered when in-flight ones are finished
+	DrainProcessor(context.Context, *admin.DrainProcessorReq) (*admin.DrainProcessorResp, error)
+	// DeregisterProcessor removes processor right away, it isn't allowed to register again on heartbeat
+	DeregisterProcessor(context.Context, *admin.DeregisterProcessorReq) (*admin.DeregisterProcessorResp, error)
+	ListProcessors(context.Context, *admin.ListProcessorsReq) (*admin.ListProcessorsResp, error)
+	GetBuffer(context.Context, *admin.GetBufferReq) (*admin.GetBufferResp, error)
+	mustEmbedUnimplementedDispatcherAdminServer()
+}
+
+// UnimplementedDispatcherAdminServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDispatcherAdminServer struct{}
+
+func (UnimplementedDispatcherAdminServer) SetBufferCapacity(context.Context, *admin.SetBufferCapacityReq) (*admin.SetBufferCapacityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBufferCapacity not implemented")
+}
+func (UnimplementedDispatcherAdminServer) SetEvictionPolicy(context.Context, *admin.SetEvictionPolicyReq) (*admin.SetEvictionPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEvictionPolicy not implemented")
+}
+func (UnimplementedDispatcherAdminServer) SetSelectionStrategy(context.Context, *admin.SetSelectionStrategyReq) (*admin.SetSelectionStrategyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSelectionStrategy not implemented")
+}
+func (UnimplementedDispatcherAdminServer) DrainProcessor(context.Context, *admin.DrainProcessorReq) (*admin.DrainProcessorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainProcessor not implemented")
+}
+func (UnimplementedDispatcherAdminServer) DeregisterProcessor(context.Context, *admin.DeregisterProcessorReq) (*admin.DeregisterProcessorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterProcessor not implemented")
+}
+func (UnimplementedDispatcherAdminServer) ListProcessors(context.Context, *admin.ListProcessorsReq) (*admin.ListProcessorsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProcessors not implemented")
+}
+func (UnimplementedDispatcherAdminServer) GetBuffer(context.Context, *admin.GetBufferReq) (*admin.GetBufferResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuffer not implemented")
+}
+func (UnimplementedDispatcherAdminServer) mustEmbedUnimplementedDispatcherAdminServer() {}
+func (UnimplementedDispatcherAdminServer) testEmbeddedByValue()                         {}
+
+// UnsafeDispatcherAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DispatcherAdminServer will
+// result in compilation errors.
+type UnsafeDispatcherAdminServer interface {
+	mustEmbedUnimplementedDispatcherAdminServer()
+}
+
+func RegisterDispatcherAdminServer(s grpc.ServiceRegistrar, srv DispatcherAdminServer) {
+	// If the following call pancis, it indicates UnimplementedDispatcherAdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DispatcherAdmin_ServiceDesc, srv)
+}
+
+func _DispatcherAdmin_SetBufferCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(admin.SetBufferCapacityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherAdminServer).SetBufferCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DispatcherAdmin_SetBufferCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherAdminServer).SetBufferCapacity(ctx, req.(*admin.SetBufferCapacityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DispatcherAdmin_SetEvictionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(admin.SetEvictionPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherAdminServer).SetEvictionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DispatcherAdmin_SetEvictionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherAdminServer).SetEvictionPolicy(ctx, req.(*admin.SetEvictionPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DispatcherAdmin_SetSelectionStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(admin.SetSelectionStrategyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherAdminServer).SetSelectionStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DispatcherAdmin_SetSelectionStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherAdminServer).SetSelectionStrategy(ctx, req.(*admin.SetSelectionStrategyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DispatcherAdmin_DrainProcessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(admin.DrainProcessorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherAdminServer).DrainProcessor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DispatcherAdmin_DrainProcessor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherAdminServer).DrainProcessor(ctx, req.(*admin.DrainProcessorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DispatcherAdmin_DeregisterProcessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(admin.DeregisterProcessorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherAdminServer).DeregisterProcessor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DispatcherAdmin_DeregisterProcessor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherAdminServer).DeregisterProcessor(ctx, req.(*admin.DeregisterProcessorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DispatcherAdmin_ListProcessors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(admin.ListProcessorsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherAdminServer).ListProcessors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DispatcherAdmin_ListProcessors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherAdminServer).ListProcessors(ctx, req.(*admin.ListProcessorsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DispatcherAdmin_GetBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(admin.GetBufferReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherAdminServer).GetBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DispatcherAdmin_GetBuffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherAdminServer).GetBuffer(ctx, req.(*admin.GetBufferReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DispatcherAdmin_ServiceDesc is the grpc.ServiceDesc for DispatcherAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DispatcherAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dispatcher_admin.DispatcherAdmin",
+	HandlerType: (*DispatcherAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetBufferCapacity",
+			Handler:    _DispatcherAdmin_SetBufferCapacity_Handler,
+		},
+		{
+			MethodName: "SetEvictionPolicy",
+			Handler:    _DispatcherAdmin_SetEvictionPolicy_Handler,
+		},
+		{
+			MethodName: "SetSelectionStrategy",
+			Handler:    _DispatcherAdmin_SetSelectionStrategy_Handler,
+		},
+		{
+			MethodName: "DrainProcessor",
+			Handler:    _DispatcherAdmin_DrainProcessor_Handler,
+		},
+		{
+			MethodName: "DeregisterProcessor",
+			Handler:    _DispatcherAdmin_DeregisterProcessor_Handler,
+		},
+		{
+			MethodName: "ListProcessors",
+			Handler:    _DispatcherAdmin_ListProcessors_Handler,
+		},
+		{
+			MethodName: "GetBuffer",
+			Handler:    _DispatcherAdmin_GetBuffer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/dispatcher_admin/dispatcher_admin.proto",
+}
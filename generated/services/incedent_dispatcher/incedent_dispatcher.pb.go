@@ -8,7 +8,9 @@ package incedent_dispatcher
 
 import (
 	incedent "github.com/PonomarevAlexxander/queuing-system/messages/incedent"
+	journal "github.com/PonomarevAlexxander/queuing-system/messages/journal"
 	registration "github.com/PonomarevAlexxander/queuing-system/messages/registration"
+	statistics "github.com/PonomarevAlexxander/queuing-system/messages/statistics"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -31,44 +33,126 @@ var file_services_incedent_dispatcher_incedent_dispatcher_proto_rawDesc = []byte
 	0x6e, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x1a, 0x20, 0x6d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x69, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74,
 	0x2f, 0x69, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x28, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
 	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xba, 0x01, 0x0a, 0x12, 0x49, 0x6e,
-	0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72,
-	0x12, 0x44, 0x0a, 0x0b, 0x4e, 0x65, 0x77, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x12,
-	0x18, 0x2e, 0x69, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x65, 0x77, 0x49, 0x6e,
-	0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x69, 0x6e, 0x63, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x65, 0x77, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x22, 0x2e, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a,
-	0x23, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32,
+	0x8e, 0x07, 0x0a, 0x12, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0b, 0x4e, 0x65, 0x77, 0x49, 0x6e, 0x63,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x69, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x2e, 0x4e, 0x65, 0x77, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x19, 0x2e, 0x69, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x65, 0x77, 0x49, 0x6e,
+	0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x18,
+	0x2e, 0x69, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x65, 0x77, 0x49, 0x6e, 0x63,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x69, 0x6e, 0x63, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e,
+	0x69, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x69, 0x6e, 0x63,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x69, 0x6e,
+	0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x69, 0x6e, 0x63, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5e, 0x0a, 0x11, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12,
+	0x22, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50,
 	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x50, 0x6f, 0x6e, 0x6f, 0x6d, 0x61, 0x72, 0x65, 0x76, 0x41, 0x6c,
-	0x65, 0x78, 0x78, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67,
-	0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2f, 0x69, 0x6e, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74,
-	0x63, 0x68, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x1a, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x24, 0x2e, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a,
+	0x25, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x0f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x54, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x18, 0x2e, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01,
+	0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x50,
+	0x6f, 0x6e, 0x6f, 0x6d, 0x61, 0x72, 0x65, 0x76, 0x41, 0x6c, 0x65, 0x78, 0x78, 0x61, 0x6e, 0x64,
+	0x65, 0x72, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x69, 0x6e, 0x63, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_services_incedent_dispatcher_incedent_dispatcher_proto_goTypes = []any{
-	(*incedent.NewIncedentReq)(nil),            // 0: incedent.NewIncedentReq
-	(*registration.ProcessorRegisterReq)(nil),  // 1: registration.ProcessorRegisterReq
-	(*incedent.NewIncedentResp)(nil),           // 2: incedent.NewIncedentResp
-	(*registration.ProcessorRegisterResp)(nil), // 3: registration.ProcessorRegisterResp
+	(*incedent.NewIncedentReq)(nil),              // 0: incedent.NewIncedentReq
+	(*incedent.IncedentStatusReq)(nil),           // 1: incedent.IncedentStatusReq
+	(*registration.ProcessorRegisterReq)(nil),    // 2: registration.ProcessorRegisterReq
+	(*registration.HeartbeatReq)(nil),            // 3: registration.HeartbeatReq
+	(*registration.ProcessorDeregisterReq)(nil),  // 4: registration.ProcessorDeregisterReq
+	(*statistics.StatisticsReq)(nil),             // 5: statistics.StatisticsReq
+	(*statistics.WatchStatisticsReq)(nil),        // 6: statistics.WatchStatisticsReq
+	(*statistics.ResetStatisticsReq)(nil),        // 7: statistics.ResetStatisticsReq
+	(*journal.WatchJournalReq)(nil),              // 8: journal.WatchJournalReq
+	(*incedent.NewIncedentResp)(nil),             // 9: incedent.NewIncedentResp
+	(*incedent.SubmitIncedentResp)(nil),          // 10: incedent.SubmitIncedentResp
+	(*incedent.IncedentStatusResp)(nil),          // 11: incedent.IncedentStatusResp
+	(*registration.ProcessorRegisterResp)(nil),   // 12: registration.ProcessorRegisterResp
+	(*registration.HeartbeatResp)(nil),           // 13: registration.HeartbeatResp
+	(*registration.ProcessorDeregisterResp)(nil), // 14: registration.ProcessorDeregisterResp
+	(*statistics.StatisticsResp)(nil),            // 15: statistics.StatisticsResp
+	(*statistics.ResetStatisticsResp)(nil),       // 16: statistics.ResetStatisticsResp
+	(*journal.JournalEvent)(nil),                 // 17: journal.JournalEvent
 }
 var file_services_incedent_dispatcher_incedent_dispatcher_proto_depIdxs = []int32{
-	0, // 0: incedent_dispatcher.IncedentDispatcher.NewIncedent:input_type -> incedent.NewIncedentReq
-	1, // 1: incedent_dispatcher.IncedentDispatcher.RegisterProcessor:input_type -> registration.ProcessorRegisterReq
-	2, // 2: incedent_dispatcher.IncedentDispatcher.NewIncedent:output_type -> incedent.NewIncedentResp
-	3, // 3: incedent_dispatcher.IncedentDispatcher.RegisterProcessor:output_type -> registration.ProcessorRegisterResp
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: incedent_dispatcher.IncedentDispatcher.NewIncedent:input_type -> incedent.NewIncedentReq
+	0,  // 1: incedent_dispatcher.IncedentDispatcher.SubmitIncedent:input_type -> incedent.NewIncedentReq
+	1,  // 2: incedent_dispatcher.IncedentDispatcher.GetIncedentStatus:input_type -> incedent.IncedentStatusReq
+	1,  // 3: incedent_dispatcher.IncedentDispatcher.WatchIncedent:input_type -> incedent.IncedentStatusReq
+	2,  // 4: incedent_dispatcher.IncedentDispatcher.RegisterProcessor:input_type -> registration.ProcessorRegisterReq
+	3,  // 5: incedent_dispatcher.IncedentDispatcher.Heartbeat:input_type -> registration.HeartbeatReq
+	4,  // 6: incedent_dispatcher.IncedentDispatcher.DeregisterProcessor:input_type -> registration.ProcessorDeregisterReq
+	5,  // 7: incedent_dispatcher.IncedentDispatcher.GetStatistics:input_type -> statistics.StatisticsReq
+	6,  // 8: incedent_dispatcher.IncedentDispatcher.WatchStatistics:input_type -> statistics.WatchStatisticsReq
+	7,  // 9: incedent_dispatcher.IncedentDispatcher.ResetStatistics:input_type -> statistics.ResetStatisticsReq
+	8,  // 10: incedent_dispatcher.IncedentDispatcher.WatchJournal:input_type -> journal.WatchJournalReq
+	9,  // 11: incedent_dispatcher.IncedentDispatcher.NewIncedent:output_type -> incedent.NewIncedentResp
+	10, // 12: incedent_dispatcher.IncedentDispatcher.SubmitIncedent:output_type -> incedent.SubmitIncedentResp
+	11, // 13: incedent_dispatcher.IncedentDispatcher.GetIncedentStatus:output_type -> incedent.IncedentStatusResp
+	11, // 14: incedent_dispatcher.IncedentDispatcher.WatchIncedent:output_type -> incedent.IncedentStatusResp
+	12, // 15: incedent_dispatcher.IncedentDispatcher.RegisterProcessor:output_type -> registration.ProcessorRegisterResp
+	13, // 16: incedent_dispatcher.IncedentDispatcher.Heartbeat:output_type -> registration.HeartbeatResp
+	14, // 17: incedent_dispatcher.IncedentDispatcher.DeregisterProcessor:output_type -> registration.ProcessorDeregisterResp
+	15, // 18: incedent_dispatcher.IncedentDispatcher.GetStatistics:output_type -> statistics.StatisticsResp
+	15, // 19: incedent_dispatcher.IncedentDispatcher.WatchStatistics:output_type -> statistics.StatisticsResp
+	16, // 20: incedent_dispatcher.IncedentDispatcher.ResetStatistics:output_type -> statistics.ResetStatisticsResp
+	17, // 21: incedent_dispatcher.IncedentDispatcher.WatchJournal:output_type -> journal.JournalEvent
+	11, // [11:22] is the sub-list for method output_type
+	0,  // [0:11] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_services_incedent_dispatcher_incedent_dispatcher_proto_init() }
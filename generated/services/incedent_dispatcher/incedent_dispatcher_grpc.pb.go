@@ -9,7 +9,9 @@ package incedent_dispatcher
 import (
 	context "context"
 	incedent "github.com/PonomarevAlexxander/queuing-system/messages/incedent"
+	journal "github.com/PonomarevAlexxander/queuing-system/messages/journal"
 	registration "github.com/PonomarevAlexxander/queuing-system/messages/registration"
+	statistics "github.com/PonomarevAlexxander/queuing-system/messages/statistics"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -21,8 +23,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	IncedentDispatcher_NewIncedent_FullMethodName       = "/incedent_dispatcher.IncedentDispatcher/NewIncedent"
-	IncedentDispatcher_RegisterProcessor_FullMethodName = "/incedent_dispatcher.IncedentDispatcher/RegisterProcessor"
+	IncedentDispatcher_NewIncedent_FullMethodName         = "/incedent_dispatcher.IncedentDispatcher/NewIncedent"
+	IncedentDispatcher_SubmitIncedent_FullMethodName      = "/incedent_dispatcher.IncedentDispatcher/SubmitIncedent"
+	IncedentDispatcher_GetIncedentStatus_FullMethodName   = "/incedent_dispatcher.IncedentDispatcher/GetIncedentStatus"
+	IncedentDispatcher_WatchIncedent_FullMethodName       = "/incedent_dispatcher.IncedentDispatcher/WatchIncedent"
+	IncedentDispatcher_RegisterProcessor_FullMethodName   = "/incedent_dispatcher.IncedentDispatcher/RegisterProcessor"
+	IncedentDispatcher_Heartbeat_FullMethodName           = "/incedent_dispatcher.IncedentDispatcher/Heartbeat"
+	IncedentDispatcher_DeregisterProcessor_FullMethodName = "/incedent_dispatcher.IncedentDispatcher/DeregisterProcessor"
+	IncedentDispatcher_GetStatistics_FullMethodName       = "/incedent_dispatcher.IncedentDispatcher/GetStatistics"
+	IncedentDispatcher_WatchStatistics_FullMethodName     = "/incedent_dispatcher.IncedentDispatcher/WatchStatistics"
+	IncedentDispatcher_ResetStatistics_FullMethodName     = "/incedent_dispatcher.IncedentDispatcher/ResetStatistics"
+	IncedentDispatcher_WatchJournal_FullMethodName        = "/incedent_dispatcher.IncedentDispatcher/WatchJournal"
 )
 
 // IncedentDispatcherClient is the client API for IncedentDispatcher service.
@@ -30,7 +41,16 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type IncedentDispatcherClient interface {
 	NewIncedent(ctx context.Context, in *incedent.NewIncedentReq, opts ...grpc.CallOption) (*incedent.NewIncedentResp, error)
+	SubmitIncedent(ctx context.Context, in *incedent.NewIncedentReq, opts ...grpc.CallOption) (*incedent.SubmitIncedentResp, error)
+	GetIncedentStatus(ctx context.Context, in *incedent.IncedentStatusReq, opts ...grpc.CallOption) (*incedent.IncedentStatusResp, error)
+	WatchIncedent(ctx context.Context, in *incedent.IncedentStatusReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[incedent.IncedentStatusResp], error)
 	RegisterProcessor(ctx context.Context, in *registration.ProcessorRegisterReq, opts ...grpc.CallOption) (*registration.ProcessorRegisterResp, error)
+	Heartbeat(ctx context.Context, in *registration.HeartbeatReq, opts ...grpc.CallOption) (*registration.HeartbeatResp, error)
+	DeregisterProcessor(ctx context.Context, in *registration.ProcessorDeregisterReq, opts ...grpc.CallOption) (*registration.ProcessorDeregisterResp, error)
+	GetStatistics(ctx context.Context, in *statistics.StatisticsReq, opts ...grpc.CallOption) (*statistics.StatisticsResp, error)
+	WatchStatistics(ctx context.Context, in *statistics.WatchStatisticsReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[statistics.StatisticsResp], error)
+	ResetStatistics(ctx context.Context, in *statistics.ResetStatisticsReq, opts ...grpc.CallOption) (*statistics.ResetStatisticsResp, error)
+	WatchJournal(ctx context.Context, in *journal.WatchJournalReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[journal.JournalEvent], error)
 }
 
 type incedentDispatcherClient struct {
@@ -51,6 +71,45 @@ func (c *incedentDispatcherClient) NewIncedent(ctx context.Context, in *incedent
 	return out, nil
 }
 
+func (c *incedentDispatcherClient) SubmitIncedent(ctx context.Context, in *incedent.NewIncedentReq, opts ...grpc.CallOption) (*incedent.SubmitIncedentResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(incedent.SubmitIncedentResp)
+	err := c.cc.Invoke(ctx, IncedentDispatcher_SubmitIncedent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incedentDispatcherClient) GetIncedentStatus(ctx context.Context, in *incedent.IncedentStatusReq, opts ...grpc.CallOption) (*incedent.IncedentStatusResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(incedent.IncedentStatusResp)
+	err := c.cc.Invoke(ctx, IncedentDispatcher_GetIncedentStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incedentDispatcherClient) WatchIncedent(ctx context.Context, in *incedent.IncedentStatusReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[incedent.IncedentStatusResp], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IncedentDispatcher_ServiceDesc.Streams[0], IncedentDispatcher_WatchIncedent_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[incedent.IncedentStatusReq, incedent.IncedentStatusResp]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IncedentDispatcher_WatchIncedentClient = grpc.ServerStreamingClient[incedent.IncedentStatusResp]
+
 func (c *incedentDispatcherClient) RegisterProcessor(ctx context.Context, in *registration.ProcessorRegisterReq, opts ...grpc.CallOption) (*registration.ProcessorRegisterResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(registration.ProcessorRegisterResp)
@@ -61,12 +120,99 @@ func (c *incedentDispatcherClient) RegisterProcessor(ctx context.Context, in *re
 	return out, nil
 }
 
+func (c *incedentDispatcherClient) Heartbeat(ctx context.Context, in *registration.HeartbeatReq, opts ...grpc.CallOption) (*registration.HeartbeatResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(registration.HeartbeatResp)
+	err := c.cc.Invoke(ctx, IncedentDispatcher_Heartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incedentDispatcherClient) DeregisterProcessor(ctx context.Context, in *registration.ProcessorDeregisterReq, opts ...grpc.CallOption) (*registration.ProcessorDeregisterResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(registration.ProcessorDeregisterResp)
+	err := c.cc.Invoke(ctx, IncedentDispatcher_DeregisterProcessor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incedentDispatcherClient) GetStatistics(ctx context.Context, in *statistics.StatisticsReq, opts ...grpc.CallOption) (*statistics.StatisticsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(statistics.StatisticsResp)
+	err := c.cc.Invoke(ctx, IncedentDispatcher_GetStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incedentDispatcherClient) WatchStatistics(ctx context.Context, in *statistics.WatchStatisticsReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[statistics.StatisticsResp], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IncedentDispatcher_ServiceDesc.Streams[1], IncedentDispatcher_WatchStatistics_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[statistics.WatchStatisticsReq, statistics.StatisticsResp]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IncedentDispatcher_WatchStatisticsClient = grpc.ServerStreamingClient[statistics.StatisticsResp]
+
+func (c *incedentDispatcherClient) ResetStatistics(ctx context.Context, in *statistics.ResetStatisticsReq, opts ...grpc.CallOption) (*statistics.ResetStatisticsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(statistics.ResetStatisticsResp)
+	err := c.cc.Invoke(ctx, IncedentDispatcher_ResetStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incedentDispatcherClient) WatchJournal(ctx context.Context, in *journal.WatchJournalReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[journal.JournalEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IncedentDispatcher_ServiceDesc.Streams[2], IncedentDispatcher_WatchJournal_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[journal.WatchJournalReq, journal.JournalEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IncedentDispatcher_WatchJournalClient = grpc.ServerStreamingClient[journal.JournalEvent]
+
 // IncedentDispatcherServer is the server API for IncedentDispatcher service.
 // All implementations must embed UnimplementedIncedentDispatcherServer
 // for forward compatibility.
 type IncedentDispatcherServer interface {
 	NewIncedent(context.Context, *incedent.NewIncedentReq) (*incedent.NewIncedentResp, error)
+	SubmitIncedent(context.Context, *incedent.NewIncedentReq) (*incedent.SubmitIncedentResp, error)
+	GetIncedentStatus(context.Context, *incedent.IncedentStatusReq) (*incedent.IncedentStatusResp, error)
+	WatchIncedent(*incedent.IncedentStatusReq, grpc.ServerStreamingServer[incedent.IncedentStatusResp]) error
 	RegisterProcessor(context.Context, *registration.ProcessorRegisterReq) (*registration.ProcessorRegisterResp, error)
+	Heartbeat(context.Context, *registration.HeartbeatReq) (*registration.HeartbeatResp, error)
+	DeregisterProcessor(context.Context, *registration.ProcessorDeregisterReq) (*registration.ProcessorDeregisterResp, error)
+	GetStatistics(context.Context, *statistics.StatisticsReq) (*statistics.StatisticsResp, error)
+	WatchStatistics(*statistics.WatchStatisticsReq, grpc.ServerStreamingServer[statistics.StatisticsResp]) error
+	ResetStatistics(context.Context, *statistics.ResetStatisticsReq) (*statistics.ResetStatisticsResp, error)
+	WatchJournal(*journal.WatchJournalReq, grpc.ServerStreamingServer[journal.JournalEvent]) error
 	mustEmbedUnimplementedIncedentDispatcherServer()
 }
 
@@ -80,9 +226,36 @@ type UnimplementedIncedentDispatcherServer struct{}
 func (UnimplementedIncedentDispatcherServer) NewIncedent(context.Context, *incedent.NewIncedentReq) (*incedent.NewIncedentResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewIncedent not implemented")
 }
+func (UnimplementedIncedentDispatcherServer) SubmitIncedent(context.Context, *incedent.NewIncedentReq) (*incedent.SubmitIncedentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitIncedent not implemented")
+}
+func (UnimplementedIncedentDispatcherServer) GetIncedentStatus(context.Context, *incedent.IncedentStatusReq) (*incedent.IncedentStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncedentStatus not implemented")
+}
+func (UnimplementedIncedentDispatcherServer) WatchIncedent(*incedent.IncedentStatusReq, grpc.ServerStreamingServer[incedent.IncedentStatusResp]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchIncedent not implemented")
+}
 func (UnimplementedIncedentDispatcherServer) RegisterProcessor(context.Context, *registration.ProcessorRegisterReq) (*registration.ProcessorRegisterResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterProcessor not implemented")
 }
+func (UnimplementedIncedentDispatcherServer) Heartbeat(context.Context, *registration.HeartbeatReq) (*registration.HeartbeatResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedIncedentDispatcherServer) DeregisterProcessor(context.Context, *registration.ProcessorDeregisterReq) (*registration.ProcessorDeregisterResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterProcessor not implemented")
+}
+func (UnimplementedIncedentDispatcherServer) GetStatistics(context.Context, *statistics.StatisticsReq) (*statistics.StatisticsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatistics not implemented")
+}
+func (UnimplementedIncedentDispatcherServer) WatchStatistics(*statistics.WatchStatisticsReq, grpc.ServerStreamingServer[statistics.StatisticsResp]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStatistics not implemented")
+}
+func (UnimplementedIncedentDispatcherServer) ResetStatistics(context.Context, *statistics.ResetStatisticsReq) (*statistics.ResetStatisticsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetStatistics not implemented")
+}
+func (UnimplementedIncedentDispatcherServer) WatchJournal(*journal.WatchJournalReq, grpc.ServerStreamingServer[journal.JournalEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJournal not implemented")
+}
 func (UnimplementedIncedentDispatcherServer) mustEmbedUnimplementedIncedentDispatcherServer() {}
 func (UnimplementedIncedentDispatcherServer) testEmbeddedByValue()                            {}
 
@@ -122,6 +295,53 @@ func _IncedentDispatcher_NewIncedent_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IncedentDispatcher_SubmitIncedent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(incedent.NewIncedentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncedentDispatcherServer).SubmitIncedent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncedentDispatcher_SubmitIncedent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncedentDispatcherServer).SubmitIncedent(ctx, req.(*incedent.NewIncedentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncedentDispatcher_GetIncedentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(incedent.IncedentStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncedentDispatcherServer).GetIncedentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncedentDispatcher_GetIncedentStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncedentDispatcherServer).GetIncedentStatus(ctx, req.(*incedent.IncedentStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncedentDispatcher_WatchIncedent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(incedent.IncedentStatusReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IncedentDispatcherServer).WatchIncedent(m, &grpc.GenericServerStream[incedent.IncedentStatusReq, incedent.IncedentStatusResp]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IncedentDispatcher_WatchIncedentServer = grpc.ServerStreamingServer[incedent.IncedentStatusResp]
+
 func _IncedentDispatcher_RegisterProcessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(registration.ProcessorRegisterReq)
 	if err := dec(in); err != nil {
@@ -140,6 +360,100 @@ func _IncedentDispatcher_RegisterProcessor_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IncedentDispatcher_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(registration.HeartbeatReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncedentDispatcherServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncedentDispatcher_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncedentDispatcherServer).Heartbeat(ctx, req.(*registration.HeartbeatReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncedentDispatcher_DeregisterProcessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(registration.ProcessorDeregisterReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncedentDispatcherServer).DeregisterProcessor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncedentDispatcher_DeregisterProcessor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncedentDispatcherServer).DeregisterProcessor(ctx, req.(*registration.ProcessorDeregisterReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncedentDispatcher_GetStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(statistics.StatisticsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncedentDispatcherServer).GetStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncedentDispatcher_GetStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncedentDispatcherServer).GetStatistics(ctx, req.(*statistics.StatisticsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncedentDispatcher_WatchStatistics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(statistics.WatchStatisticsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IncedentDispatcherServer).WatchStatistics(m, &grpc.GenericServerStream[statistics.WatchStatisticsReq, statistics.StatisticsResp]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IncedentDispatcher_WatchStatisticsServer = grpc.ServerStreamingServer[statistics.StatisticsResp]
+
+func _IncedentDispatcher_ResetStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(statistics.ResetStatisticsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncedentDispatcherServer).ResetStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncedentDispatcher_ResetStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncedentDispatcherServer).ResetStatistics(ctx, req.(*statistics.ResetStatisticsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncedentDispatcher_WatchJournal_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(journal.WatchJournalReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IncedentDispatcherServer).WatchJournal(m, &grpc.GenericServerStream[journal.WatchJournalReq, journal.JournalEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IncedentDispatcher_WatchJournalServer = grpc.ServerStreamingServer[journal.JournalEvent]
+
 // IncedentDispatcher_ServiceDesc is the grpc.ServiceDesc for IncedentDispatcher service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -151,11 +465,51 @@ var IncedentDispatcher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NewIncedent",
 			Handler:    _IncedentDispatcher_NewIncedent_Handler,
 		},
+		{
+			MethodName: "SubmitIncedent",
+			Handler:    _IncedentDispatcher_SubmitIncedent_Handler,
+		},
+		{
+			MethodName: "GetIncedentStatus",
+			Handler:    _IncedentDispatcher_GetIncedentStatus_Handler,
+		},
 		{
 			MethodName: "RegisterProcessor",
 			Handler:    _IncedentDispatcher_RegisterProcessor_Handler,
 		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _IncedentDispatcher_Heartbeat_Handler,
+		},
+		{
+			MethodName: "DeregisterProcessor",
+			Handler:    _IncedentDispatcher_DeregisterProcessor_Handler,
+		},
+		{
+			MethodName: "GetStatistics",
+			Handler:    _IncedentDispatcher_GetStatistics_Handler,
+		},
+		{
+			MethodName: "ResetStatistics",
+			Handler:    _IncedentDispatcher_ResetStatistics_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchIncedent",
+			Handler:       _IncedentDispatcher_WatchIncedent_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchStatistics",
+			Handler:       _IncedentDispatcher_WatchStatistics_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchJournal",
+			Handler:       _IncedentDispatcher_WatchJournal_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/incedent_dispatcher/incedent_dispatcher.proto",
 }
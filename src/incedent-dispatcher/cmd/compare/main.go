@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/alexflint/go-arg"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/config"
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/repositories"
+	common_config "github.com/PonomarevAlexxander/queuing-system/utils/config"
+)
+
+// compare prints M/M/n/K characteristics of the run next to the measured ones,
+// run is described in the simulation config format
+var args struct {
+	Config string `arg:"required" help:"simulation config describing producers, processors and buffer"`
+	Report string `arg:"required" help:"json report written by dispatcher or simulation"`
+}
+
+func main() {
+	arg.MustParse(&args)
+
+	cfg, err := common_config.ReadConfigFromYAML[config.SimulationConfig](args.Config)
+	if err != nil {
+		panic(err)
+	}
+
+	err = common_config.ValidateConfig(cfg)
+	if err != nil {
+		panic(err)
+	}
+
+	report, err := repositories.ReadReport(args.Report)
+	if err != nil {
+		panic(err)
+	}
+
+	if !cfg.IsMarkovian() {
+		fmt.Fprintln(os.Stderr, "intervals aren't exponential, model is an approximation")
+	}
+	rows := domain.CompareWithModel(cfg.GetMarkovModel().Solve(), report)
+	if err := repositories.WriteComparison(os.Stdout, rows); err != nil {
+		panic(err)
+	}
+}
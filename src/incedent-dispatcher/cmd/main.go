@@ -7,6 +7,7 @@ import (
 
 	"github.com/alexflint/go-arg"
 	"github.com/benbjohnson/clock"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/controllers"
 	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/repositories"
 	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/usecases"
+	"github.com/PonomarevAlexxander/queuing-system/services/dispatcher_admin"
 	"github.com/PonomarevAlexxander/queuing-system/services/incedent_dispatcher"
 	common_config "github.com/PonomarevAlexxander/queuing-system/utils/config"
 	grpc_controller "github.com/PonomarevAlexxander/queuing-system/utils/grpc_controller"
@@ -48,11 +50,43 @@ func main() {
 	defer cancel()
 
 	clk := clock.New()
-	bfStorage := repositories.NewBufferStorage(log, cfg.InnerConfig.BufferCapacity)
-	procStorage := repositories.NewProcessorStorage()
-	mStorage := repositories.NewMetricsStorage(log, clk)
-	registrationUC := usecases.NewRegistrationUseCase(log, procStorage, mStorage)
-	dispatcherUC := usecases.NewIncedentDispatcher(log, clk, bfStorage, procStorage, mStorage)
+	policy, err := repositories.NewEvictionPolicy(cfg.InnerConfig.EvictionPolicy)
+	if err != nil {
+		log.Fatal("Failed to create eviction policy", zap.Error(err))
+	}
+	var wal *repositories.WriteAheadLog
+	if cfg.Persistence.Enabled {
+		wal, err = repositories.NewWriteAheadLog(
+			log,
+			clk,
+			cfg.Persistence.Path,
+			cfg.Persistence.Sync,
+			cfg.Persistence.GetCompactionInterval(),
+		)
+		if err != nil {
+			log.Fatal("Failed to open write-ahead log", zap.Error(err))
+		}
+	}
+	var journal *repositories.Journal
+	if cfg.Journal.Enabled {
+		journal = repositories.NewJournal(clk, cfg.Journal.GetCapacity())
+	}
+	bfStorage := repositories.NewBufferStorage(log, cfg.InnerConfig.BufferCapacity, policy, wal, journal)
+	strategy, err := repositories.NewSelectionStrategy(cfg.InnerConfig.SelectionStrategy)
+	if err != nil {
+		log.Fatal("Failed to create selection strategy", zap.Error(err))
+	}
+	procStorage := repositories.NewProcessorStorage(clk, strategy)
+	registry := prometheus.NewRegistry()
+	statistics := repositories.NewMetricsStorage(log, clk,
+		cfg.Statistics.GetPrecision(), cfg.Statistics.WarmUp.GetWarmUp())
+	mStorage := repositories.NewPrometheusMetricsStorage(statistics, clk, registry)
+	registrationUC := usecases.NewRegistrationUseCase(log, clk, procStorage, mStorage,
+		cfg.InnerConfig.GetLeaseDuration())
+	dispatcherUC := usecases.NewIncedentDispatcher(
+		log, clk, bfStorage, procStorage, mStorage, journal,
+		cfg.InnerConfig.Retry.GetRetryPolicy(),
+	)
 
 	lis, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", cfg.InnerConfig.Port))
 	if err != nil {
@@ -60,10 +94,40 @@ func main() {
 	}
 
 	grpcServer := grpc.NewServer()
-	dispatcherController := controllers.NewGrpcController(log, registrationUC, dispatcherUC)
+	statisticsUC := usecases.NewStatisticsUseCase(log, clk, mStorage)
+	journalUC := usecases.NewJournalUseCase(log, journal)
+	dispatcherController := controllers.NewGrpcController(log, registrationUC, dispatcherUC, statisticsUC, journalUC)
 	incedent_dispatcher.RegisterIncedentDispatcherServer(grpcServer, dispatcherController)
+	adminUC := usecases.NewAdminUseCase(log, clk, bfStorage, procStorage, mStorage, dispatcherUC, registrationUC)
+	dispatcher_admin.RegisterDispatcherAdminServer(grpcServer, controllers.NewAdminController(log, adminUC))
 	controller := grpc_controller.NewGrpcController(grpcServer, lis)
 
-	srvcRunner.Run(ctx, registrationUC, dispatcherUC, controller)
+	services := []runner.Service{registrationUC, dispatcherUC, adminUC, controller}
+	if wal != nil {
+		services = append(services, wal)
+	}
+	if cfg.Report.Path != "" {
+		writer := repositories.NewReportWriter(cfg.Report.Path)
+		services = append(services, usecases.NewReportUseCase(log, mStorage, writer))
+	}
+	if journal != nil && cfg.Journal.Path != "" {
+		writer, err := repositories.NewJournalWriter(cfg.Journal.Path)
+		if err != nil {
+			log.Fatal("Failed to create journal dump", zap.Error(err))
+		}
+		services = append(services, usecases.NewJournalDumpUseCase(log, journal, writer))
+	}
+	if cfg.Statistics.Accuracy > 0 {
+		services = append(services, usecases.NewStopRuleUseCase(log, clk, mStorage,
+			cfg.Statistics.GetCheckInterval(), cancel))
+	}
+	if cfg.Http.Enabled {
+		services = append(services, controllers.NewHttpController(log, clk, cfg.Http.Port,
+			dispatcherUC, adminUC, statisticsUC))
+	}
+	if cfg.Metrics.Enabled {
+		services = append(services, controllers.NewMetricsController(log, cfg.Metrics.Port, registry))
+	}
+	srvcRunner.Run(ctx, services...)
 	mStorage.PrintStatistics()
 }
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/alexflint/go-arg"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/repositories"
+	"github.com/PonomarevAlexxander/queuing-system/messages/admin"
+	"github.com/PonomarevAlexxander/queuing-system/messages/common"
+	"github.com/PonomarevAlexxander/queuing-system/messages/incedent"
+	"github.com/PonomarevAlexxander/queuing-system/messages/statistics"
+	"github.com/PonomarevAlexxander/queuing-system/services/dispatcher_admin"
+	"github.com/PonomarevAlexxander/queuing-system/services/incedent_dispatcher"
+)
+
+type processorsCmd struct{}
+
+type bufferCmd struct{}
+
+type submitCmd struct {
+	Priority uint64  `arg:"required" help:"priority of the test incedent"`
+	Id       *uint64 `help:"incedent id, current unix time in nanoseconds by default"`
+	Wait     bool    `help:"wait for the processing result instead of printing ticket"`
+	Source   string  `default:"queuectl" help:"id of the incedent producer"`
+	Category string  `help:"category of the incedent"`
+	// go-arg parses repeated key=value pairs into the map
+	Label       map[string]string `arg:"separate" help:"incedent label as key=value, can be repeated"`
+	Payload     string            `help:"payload of the incedent"`
+	ContentType string            `arg:"--content-type" default:"text/plain" help:"content type of the payload"`
+}
+
+type statsCmd struct {
+	Watch    bool          `help:"print statistics until interrupted"`
+	Interval time.Duration `default:"1s" help:"period of updates in watch mode"`
+}
+
+type processorCmd struct {
+	Id uint64 `arg:"positional,required" help:"processor id"`
+}
+
+type reportCmd struct {
+	Path string `arg:"positional,required" help:"report is written to <path>.json, <path>-priorities.csv and <path>-processors.csv"`
+}
+
+// queuectl inspects and controls running dispatcher
+var args struct {
+	Host    string        `default:"localhost:3080" help:"dispatcher address"`
+	Output  string        `default:"table" help:"output format, table or json"`
+	Timeout time.Duration `default:"10s" help:"timeout of requests, watching isn't limited"`
+
+	Processors *processorsCmd `arg:"subcommand:processors" help:"list processors and their busy slots"`
+	Buffer     *bufferCmd     `arg:"subcommand:buffer" help:"show buffered incedents per priority"`
+	Submit     *submitCmd     `arg:"subcommand:submit" help:"submit test incedent"`
+	Stats      *statsCmd      `arg:"subcommand:stats" help:"show statistics"`
+	Drain      *processorCmd  `arg:"subcommand:drain" help:"stop sending incedents to processor and deregister it when they are finished"`
+	Deregister *processorCmd  `arg:"subcommand:deregister" help:"deregister processor right away, it doesn't register again"`
+	Report     *reportCmd     `arg:"subcommand:report" help:"dump report of the current statistics"`
+}
+
+func main() {
+	p := arg.MustParse(&args)
+	if p.Subcommand() == nil {
+		p.Fail("missing subcommand")
+	}
+	out, err := newPrinter(os.Stdout, args.Output)
+	if err != nil {
+		p.Fail(err.Error())
+	}
+
+	conn, err := grpc.NewClient(args.Host, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		exit(fmt.Errorf("failed to create grpc client: %w", err))
+	}
+	defer conn.Close()
+	ctl := &controller{
+		dispatcher: incedent_dispatcher.NewIncedentDispatcherClient(conn),
+		admin:      dispatcher_admin.NewDispatcherAdminClient(conn),
+		out:        out,
+	}
+
+	if err := ctl.run(); err != nil {
+		conn.Close()
+		exit(err)
+	}
+}
+
+func exit(err error) {
+	fmt.Fprintln(os.Stderr, "queuectl:", err)
+	os.Exit(1)
+}
+
+type controller struct {
+	dispatcher incedent_dispatcher.IncedentDispatcherClient
+	admin      dispatcher_admin.DispatcherAdminClient
+	out        printer
+}
+
+func (c *controller) run() error {
+	if args.Stats != nil && args.Stats.Watch {
+		return c.watchStatistics(args.Stats.Interval)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), args.Timeout)
+	defer cancel()
+	switch {
+	case args.Processors != nil:
+		resp, err := c.admin.ListProcessors(ctx, &admin.ListProcessorsReq{})
+		if err != nil {
+			return err
+		}
+		return c.out.processors(resp)
+	case args.Buffer != nil:
+		resp, err := c.admin.GetBuffer(ctx, &admin.GetBufferReq{})
+		if err != nil {
+			return err
+		}
+		return c.out.buffer(resp)
+	case args.Submit != nil:
+		return c.submit(ctx, args.Submit)
+	case args.Stats != nil:
+		resp, err := c.dispatcher.GetStatistics(ctx, &statistics.StatisticsReq{})
+		if err != nil {
+			return err
+		}
+		return c.out.statistics(resp)
+	case args.Drain != nil:
+		resp, err := c.admin.DrainProcessor(ctx, &admin.DrainProcessorReq{Id: args.Drain.Id})
+		if err != nil {
+			return err
+		}
+		return c.out.result(resp, resp.GetResult(), fmt.Sprintf("processor %d is draining", args.Drain.Id))
+	case args.Deregister != nil:
+		resp, err := c.admin.DeregisterProcessor(ctx, &admin.DeregisterProcessorReq{Id: args.Deregister.Id})
+		if err != nil {
+			return err
+		}
+		return c.out.result(resp, resp.GetResult(), fmt.Sprintf("processor %d is deregistered", args.Deregister.Id))
+	case args.Report != nil:
+		resp, err := c.dispatcher.GetStatistics(ctx, &statistics.StatisticsReq{})
+		if err != nil {
+			return err
+		}
+		if err := repositories.NewReportWriter(args.Report.Path).Write(toDomainReport(resp)); err != nil {
+			return err
+		}
+		return c.out.result(resp, resp.GetResult(), fmt.Sprintf("report is written to %s.json", args.Report.Path))
+	}
+
+	return nil
+}
+
+func (c *controller) submit(ctx context.Context, cmd *submitCmd) error {
+	now := time.Now()
+	req := &incedent.NewIncedentReq{
+		Id:       uint64(now.UnixNano()),
+		Time:     timestamppb.New(now),
+		Priority: cmd.Priority,
+		Source:   cmd.Source,
+		Category: cmd.Category,
+		Labels:   cmd.Label,
+	}
+	if cmd.Payload != "" {
+		req.Payload = &incedent.Payload{
+			Data:        []byte(cmd.Payload),
+			ContentType: cmd.ContentType,
+		}
+	}
+	if cmd.Id != nil {
+		req.Id = *cmd.Id
+	}
+
+	if cmd.Wait {
+		resp, err := c.dispatcher.NewIncedent(ctx, req)
+		if err != nil {
+			return err
+		}
+		return c.out.result(resp, resp.GetResult(), fmt.Sprintf("incedent %d is processed", req.Id))
+	}
+	resp, err := c.dispatcher.SubmitIncedent(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return c.out.result(resp, resp.GetResult(), fmt.Sprintf("incedent %d is submitted, ticket %s", req.Id, resp.GetTicket()))
+}
+
+func (c *controller) watchStatistics(interval time.Duration) error {
+	stream, err := c.dispatcher.WatchStatistics(context.Background(), &statistics.WatchStatisticsReq{
+		Interval: durationpb.New(interval),
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if err := c.out.statistics(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// resultError turns failed result of the request into error
+func resultError(result *common.Result) error {
+	if result.GetSuccess() {
+		return nil
+	}
+
+	return errors.New(result.GetMsg())
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/PonomarevAlexxander/queuing-system/messages/admin"
+	"github.com/PonomarevAlexxander/queuing-system/messages/common"
+	"github.com/PonomarevAlexxander/queuing-system/messages/statistics"
+)
+
+const (
+	tableOutput = "table"
+	jsonOutput  = "json"
+)
+
+// printer writes responses as tables or as json, one message per line
+type printer struct {
+	w    io.Writer
+	json bool
+}
+
+func newPrinter(w io.Writer, format string) (printer, error) {
+	switch format {
+	case tableOutput:
+		return printer{w: w}, nil
+	case jsonOutput:
+		return printer{w: w, json: true}, nil
+	default:
+		return printer{}, fmt.Errorf("unknown output format '%s'", format)
+	}
+}
+
+func (p printer) processors(resp *admin.ListProcessorsResp) error {
+	if p.json {
+		return p.message(resp)
+	}
+
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "id\thost\tweight\tbusy\tdraining")
+	for _, processor := range resp.GetProcessors() {
+		fmt.Fprintf(tw, "%d\t%s\t%d\t%d/%d\t%t\n",
+			processor.GetId(), processor.GetHost(), processor.GetWeight(),
+			processor.GetBusy(), max(processor.GetCapacity(), 1), processor.GetDraining())
+	}
+
+	return tw.Flush()
+}
+
+func (p printer) buffer(resp *admin.GetBufferResp) error {
+	if p.json {
+		return p.message(resp)
+	}
+
+	fmt.Fprintf(p.w, "size %d/%d\n", resp.GetSize(), resp.GetCapacity())
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "priority\tid\tcreated")
+	for _, priority := range resp.GetPriorities() {
+		for _, incedent := range priority.GetIncedents() {
+			fmt.Fprintf(tw, "%d\t%d\t%s\n",
+				priority.GetPriority(), incedent.GetId(), incedent.GetTime().AsTime().Local().Format(time.RFC3339Nano))
+		}
+	}
+
+	return tw.Flush()
+}
+
+func (p printer) statistics(resp *statistics.StatisticsResp) error {
+	if p.json {
+		return p.message(resp)
+	}
+
+	fmt.Fprintf(p.w, "statistics at %s since %s\n",
+		resp.GetTime().AsTime().Local().Format(time.DateTime), resp.GetSince().AsTime().Local().Format(time.DateTime))
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "priority\ttotal\tprocessed\trejected\tevicted\tin progress\tp rejected\ttime in system")
+	for _, stats := range resp.GetPriorities() {
+		fmt.Fprintf(tw, "%d\t%d\t%d\t%d\t%d\t%d\t%.4f\t%s\n",
+			stats.GetPriority(), stats.GetTotal(), stats.GetProcessed(), stats.GetRejected(),
+			stats.GetEvicted(), stats.GetInProgress(), stats.GetPRejected(), stats.GetTimeInSystem().AsDuration())
+	}
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "processor\tcapacity\tuptime\tin work\tfailed attempts\tutilization\tderegistered")
+	for _, stats := range resp.GetProcessors() {
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%d\t%.4f\t%t\n",
+			stats.GetId(), stats.GetCapacity(), stats.GetUptime().AsDuration().Round(time.Millisecond),
+			stats.GetInWork().AsDuration().Round(time.Millisecond), stats.GetFailedAttempts(),
+			stats.GetUtilization(), stats.GetDeregistered())
+	}
+	fmt.Fprintln(tw)
+
+	return tw.Flush()
+}
+
+// result prints message of successful request, failed result is returned as error
+func (p printer) result(resp proto.Message, result *common.Result, msg string) error {
+	if err := resultError(result); err != nil {
+		return err
+	}
+	if p.json {
+		return p.message(resp)
+	}
+	_, err := fmt.Fprintln(p.w, msg)
+
+	return err
+}
+
+func (p printer) message(msg proto.Message) error {
+	data, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal response: %w", err)
+	}
+	_, err = fmt.Fprintln(p.w, string(data))
+
+	return err
+}
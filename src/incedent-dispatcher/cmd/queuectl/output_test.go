@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/PonomarevAlexxander/queuing-system/messages/admin"
+	"github.com/PonomarevAlexxander/queuing-system/messages/common"
+)
+
+func TestQueuectl(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Queuectl Suite")
+}
+
+var _ = Describe("Printer", func() {
+	var (
+		out       bytes.Buffer
+		processor = &admin.ListProcessorsResp{Processors: []*admin.ProcessorInfo{
+			{Id: 3, Host: "localhost:8090", Weight: 2, Busy: 1, Draining: true},
+		}}
+	)
+
+	BeforeEach(func() {
+		out.Reset()
+	})
+
+	It("Unknown format", func() {
+		_, err := newPrinter(&out, "xml")
+		Expect(err).NotTo(Succeed())
+	})
+
+	It("Prints table", func() {
+		p, err := newPrinter(&out, tableOutput)
+		Expect(err).To(Succeed())
+		Expect(p.processors(processor)).To(Succeed())
+		Expect(out.String()).To(Equal(
+			"id  host            weight  busy  draining\n" +
+				"3   localhost:8090  2       1/1   true\n"))
+
+		out.Reset()
+		Expect(p.result(&admin.DrainProcessorResp{}, &common.Result{Success: true}, "processor 3 is draining")).To(Succeed())
+		Expect(out.String()).To(Equal("processor 3 is draining\n"))
+	})
+
+	It("Prints json with unpopulated fields", func() {
+		p, err := newPrinter(&out, jsonOutput)
+		Expect(err).To(Succeed())
+		Expect(p.processors(processor)).To(Succeed())
+		var parsed admin.ListProcessorsResp
+		Expect(protojson.Unmarshal(out.Bytes(), &parsed)).To(Succeed())
+		Expect(parsed.GetProcessors()[0].GetHost()).To(Equal("localhost:8090"))
+		Expect(out.String()).To(ContainSubstring(`"capacity":0`))
+	})
+
+	It("Failed result is an error", func() {
+		p, err := newPrinter(&out, jsonOutput)
+		Expect(err).To(Succeed())
+		resp := &admin.DeregisterProcessorResp{Result: &common.Result{Msg: "processor is unknown"}}
+		Expect(p.result(resp, resp.GetResult(), "")).To(MatchError("processor is unknown"))
+		Expect(out.Len()).To(BeZero())
+	})
+})
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/messages/statistics"
+)
+
+// toDomainReport restores report from statistics response, so it is written
+// in the same format as reports of dispatcher itself
+func toDomainReport(resp *statistics.StatisticsResp) domain.Report {
+	report := domain.Report{
+		Time:       resp.GetTime().AsTime(),
+		Since:      resp.GetSince().AsTime(),
+		Priorities: make([]domain.PriorityReport, 0, len(resp.GetPriorities())),
+		Processors: make([]domain.ProcessorReport, 0, len(resp.GetProcessors())),
+	}
+	for _, p := range resp.GetPriorities() {
+		report.Priorities = append(report.Priorities, domain.PriorityReport{
+			Priority:             domain.Priority(p.GetPriority()),
+			Total:                int(p.GetTotal()),
+			Processed:            int(p.GetProcessed()),
+			Rejected:             int(p.GetRejected()),
+			Evicted:              int(p.GetEvicted()),
+			Retried:              int(p.GetRetried()),
+			InProgress:           int(p.GetInProgress()),
+			PRejected:            p.GetPRejected(),
+			TimeInSystem:         p.GetTimeInSystem().AsDuration(),
+			TimeInBuffer:         toDomainTimeStats(p.GetTimeInBuffer()),
+			TimeInProcessing:     toDomainTimeStats(p.GetTimeInProcessing()),
+			PRejectedInterval:    toDomainInterval(p.GetPRejectedInterval()),
+			TimeInSystemInterval: toDomainInterval(p.GetTimeInSystemInterval()),
+			RequiredIncedents:    int(p.GetRequiredIncedents()),
+		})
+	}
+	for _, p := range resp.GetProcessors() {
+		report.Processors = append(report.Processors, domain.ProcessorReport{
+			Id:             p.GetId(),
+			Capacity:       int(p.GetCapacity()),
+			RegTime:        p.GetRegisteredAt().AsTime(),
+			EndTime:        p.GetEndAt().AsTime(),
+			Deregistered:   p.GetDeregistered(),
+			LeaseExpired:   p.GetLeaseExpired(),
+			Uptime:         p.GetUptime().AsDuration(),
+			InWork:         p.GetInWork().AsDuration(),
+			FailedAttempts: int(p.GetFailedAttempts()),
+			Utilization:    p.GetUtilization(),
+		})
+	}
+
+	return report
+}
+
+func toDomainTimeStats(stats *statistics.TimeStats) domain.TimeStats {
+	return domain.TimeStats{
+		Mean:     stats.GetMean().AsDuration(),
+		Variance: stats.GetVariance(),
+	}
+}
+
+func toDomainInterval(interval *statistics.ConfidenceInterval) domain.ConfidenceInterval {
+	return domain.ConfidenceInterval{
+		Mean:      interval.GetMean(),
+		HalfWidth: interval.GetHalfWidth(),
+		Samples:   int(interval.GetSamples()),
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/repositories"
+	"github.com/PonomarevAlexxander/queuing-system/messages/statistics"
+)
+
+var _ = Describe("Report", func() {
+	It("Is written in dispatcher format", func() {
+		now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		resp := &statistics.StatisticsResp{
+			Time:  timestamppb.New(now),
+			Since: timestamppb.New(now.Add(-time.Minute)),
+			Priorities: []*statistics.PriorityStatistics{{
+				Priority:          2,
+				Total:             10,
+				Processed:         7,
+				Rejected:          3,
+				Evicted:           1,
+				PRejected:         0.3,
+				TimeInSystem:      durationpb.New(time.Second),
+				TimeInBuffer:      &statistics.TimeStats{Mean: durationpb.New(200 * time.Millisecond), Variance: 0.01},
+				PRejectedInterval: &statistics.ConfidenceInterval{Mean: 0.3, HalfWidth: 0.1, Samples: 10},
+			}},
+			Processors: []*statistics.ProcessorStatistics{{
+				Id:           1,
+				Capacity:     2,
+				RegisteredAt: timestamppb.New(now.Add(-time.Minute)),
+				EndAt:        timestamppb.New(now),
+				Uptime:       durationpb.New(time.Minute),
+				InWork:       durationpb.New(30 * time.Second),
+				Utilization:  0.25,
+			}},
+		}
+		expected := toDomainReport(resp)
+		Expect(expected.Priorities[0].Priority).To(Equal(domain.Priority(2)))
+		Expect(expected.Processors[0].Utilization).To(Equal(0.25))
+
+		path := filepath.Join(GinkgoT().TempDir(), "report")
+		Expect(repositories.NewReportWriter(path).Write(expected)).To(Succeed())
+		restored, err := repositories.ReadReport(path + ".json")
+		Expect(err).To(Succeed())
+		Expect(restored).To(Equal(expected))
+	})
+})
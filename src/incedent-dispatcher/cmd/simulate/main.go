@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"syscall"
+
+	"github.com/alexflint/go-arg"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/config"
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/repositories"
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/usecases"
+	common_config "github.com/PonomarevAlexxander/queuing-system/utils/config"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+	"github.com/PonomarevAlexxander/queuing-system/utils/runner"
+	"github.com/PonomarevAlexxander/queuing-system/utils/scheduler"
+)
+
+var args struct {
+	Config   string `arg:"required"`
+	Scenario string `help:"sweep scenario, config is the base of every combination"`
+}
+
+func main() {
+	arg.MustParse(&args)
+
+	cfg, err := common_config.ReadConfigFromYAML[config.SimulationConfig](args.Config)
+	if err != nil {
+		panic(err)
+	}
+
+	err = common_config.ValidateConfig(cfg)
+	if err != nil {
+		panic(err)
+	}
+
+	zapLog, err := logger.InitZapLogger(cfg.LoggerConfig)
+	if err != nil {
+		panic(err)
+	}
+	log := logger.InitZapWrapper(zapLog)
+
+	ctx, cancel, srvcRunner := runner.NewServiceRunner(log, syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	if args.Scenario != "" {
+		sweep, err := newSweep(log, *cfg, args.Scenario)
+		if err != nil {
+			log.Fatal("Failed to create sweep", zap.Error(err))
+		}
+		srvcRunner.Run(ctx, sweep)
+		return
+	}
+
+	simulation, mStorage, journal, err := newSimulation(log, *cfg, cfg.Incedents)
+	if err != nil {
+		log.Fatal("Failed to create simulation", zap.Error(err))
+	}
+	srvcRunner.Run(ctx, simulation)
+	mStorage.PrintStatistics()
+	if !cfg.IsMarkovian() {
+		log.Warn("Intervals aren't exponential, analytical model is an approximation")
+	}
+	rows := domain.CompareWithModel(cfg.GetMarkovModel().Solve(), mStorage.Report())
+	if err := repositories.WriteComparison(os.Stdout, rows); err != nil {
+		log.Error("Failed to print comparison with analytical model", zap.Error(err))
+	}
+	if cfg.Report.Path != "" {
+		writer := repositories.NewReportWriter(cfg.Report.Path)
+		if err := usecases.NewReportUseCase(log, mStorage, writer).WriteReport(); err != nil {
+			log.Error("Failed to write report", zap.Error(err))
+		}
+	}
+	if journal != nil && cfg.Journal.Path != "" {
+		if err := dumpJournal(journal, cfg.Journal.Path); err != nil {
+			log.Error("Failed to dump journal", zap.Error(err))
+		}
+	}
+}
+
+func dumpJournal(journal *repositories.Journal, path string) error {
+	writer, err := repositories.NewJournalWriter(path)
+	if err != nil {
+		return err
+	}
+	events, _ := journal.Events(0)
+	if err := writer.Write(events); err != nil {
+		writer.Close()
+		return err
+	}
+
+	return writer.Close()
+}
+
+func newSweep(log *logger.Logger, base config.SimulationConfig, scenario string) (*usecases.Sweep, error) {
+	sweepCfg, err := common_config.ReadConfigFromYAML[config.SweepConfig](scenario)
+	if err != nil {
+		return nil, err
+	}
+	if err := common_config.ValidateConfig(sweepCfg); err != nil {
+		return nil, err
+	}
+	if err := sweepCfg.CheckBase(base); err != nil {
+		return nil, err
+	}
+
+	run := func(ctx context.Context, point domain.SweepPoint, incedents int) (domain.Report, error) {
+		cfg := base.WithPoint(point)
+		// journal of every point isn't needed
+		cfg.Journal = config.JournalConfig{}
+		simulation, mStorage, _, err := newSimulation(log, cfg, incedents)
+		if err != nil {
+			return domain.Report{}, err
+		}
+		if err := simulation.Run(ctx); err != nil {
+			return domain.Report{}, err
+		}
+
+		return mStorage.Report(), nil
+	}
+
+	return usecases.NewSweep(
+		log,
+		run,
+		repositories.NewSweepWriter(sweepCfg.Results),
+		sweepCfg.GetPoints(base),
+		sweepCfg.Stop.GetStopRule(),
+		sweepCfg.RejectionThreshold,
+	), nil
+}
+
+func newSimulation(
+	log *logger.Logger,
+	cfg config.SimulationConfig,
+	incedents int,
+) (*usecases.Simulation, *repositories.MetricsStorage, *repositories.Journal, error) {
+	clk := usecases.NewVirtualClock()
+	policy, err := repositories.NewEvictionPolicy(cfg.EvictionPolicy)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var journal *repositories.Journal
+	if cfg.Journal.Enabled {
+		journal = repositories.NewJournal(clk, cfg.Journal.GetCapacity())
+	}
+	bfStorage := repositories.NewBufferStorage(log, cfg.BufferCapacity, policy, nil, journal)
+	strategy, err := repositories.NewSelectionStrategy(cfg.SelectionStrategy)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	procStorage := repositories.NewProcessorStorage(clk, strategy)
+	mStorage := repositories.NewMetricsStorage(log, clk,
+		cfg.Statistics.GetPrecision(), cfg.Statistics.WarmUp.GetWarmUp())
+
+	producers := make([]usecases.SimulatedProducer, 0, len(cfg.Producers))
+	for _, producerCfg := range cfg.Producers {
+		arrivals, err := scheduler.NewDistribution(producerCfg.Arrival, producerCfg.GetInterval())
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("arrival distribution: %w", err)
+		}
+		producers = append(producers, usecases.SimulatedProducer{
+			Priority: domain.Priority(producerCfg.Priority),
+			Arrivals: arrivals,
+		})
+	}
+	processors := make([]usecases.SimulatedProcessor, 0, len(cfg.Processors))
+	for _, processorCfg := range cfg.Processors {
+		serviceTime, err := scheduler.NewDistribution(processorCfg.ServiceTime, processorCfg.GetInterval())
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("service time distribution: %w", err)
+		}
+		processors = append(processors, usecases.SimulatedProcessor{
+			Processor: domain.IncedentProcessor{
+				Id:       processorCfg.Id,
+				Host:     "simulated",
+				Weight:   processorCfg.Weight,
+				Capacity: processorCfg.Capacity,
+			},
+			ServiceTime: serviceTime,
+		})
+	}
+
+	simulation := usecases.NewSimulation(
+		log, clk, bfStorage, procStorage, mStorage, journal,
+		producers, processors, cfg.GetDuration(), incedents,
+	)
+
+	return simulation, mStorage, journal, nil
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
 	msgs_processor "github.com/PonomarevAlexxander/queuing-system/messages/incedent"
 	srvc_processor "github.com/PonomarevAlexxander/queuing-system/services/incedent_processor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -35,10 +37,20 @@ func (dc *ProcessorClient) SendIncedent(ctx context.Context, incedent domain.Inc
 		Id:       incedent.Id,
 		Time:     timestamppb.New(incedent.CreationTime),
 		Priority: uint64(incedent.Priority),
+		Payload: &msgs_processor.Payload{
+			Data:        incedent.Payload.Data,
+			ContentType: incedent.Payload.ContentType,
+		},
+		Labels:   incedent.Labels,
+		Source:   incedent.Source,
+		Category: incedent.Category,
 	}
 
 	resp, err := dc.grpcClient.NewIncedent(ctx, req)
 	if err != nil {
+		if isTransportFailure(err) {
+			return fmt.Errorf("failed to send incedent with grpc: %w: %w", domain.ErrTransport, err)
+		}
 		return fmt.Errorf("failed to send incedent with grpc: %w", err)
 	}
 
@@ -48,3 +60,14 @@ func (dc *ProcessorClient) SendIncedent(ctx context.Context, incedent domain.Inc
 
 	return nil
 }
+
+// isTransportFailure reports whether request could succeed if sent again, incedent which
+// timed out may still be processed, so it isn't sent to another processor
+func isTransportFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
@@ -1,15 +1,151 @@
 package config
 
 import (
+	"time"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
 	common_config "github.com/PonomarevAlexxander/queuing-system/utils/config"
 )
 
+const (
+	defaultLeaseDuration  = 10 * time.Second
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 2 * time.Second
+	defaultConfidence     = 0.95
+	defaultCheckInterval  = 5 * time.Second
+	defaultJournalEvents  = 10000
+)
+
 type DispatcherConfig struct {
 	common_config.CommonConfig `yaml:",inline"`
-	InnerConfig                InnerConfig `yaml:"dispatcher" validate:"required"`
+	InnerConfig                InnerConfig       `yaml:"dispatcher" validate:"required"`
+	Persistence                PersistenceConfig `yaml:"persistence"`
+	Metrics                    MetricsConfig     `yaml:"metrics"`
+	Http                       HttpConfig        `yaml:"http"`
+	Report                     ReportConfig      `yaml:"report"`
+	Statistics                 StatisticsConfig  `yaml:"statistics"`
+	Journal                    JournalConfig     `yaml:"journal"`
 }
 
 type InnerConfig struct {
-	Port           int    `yaml:"port" validate:"required"`
-	BufferCapacity uint64 `yaml:"buffer-capacity" validate:"required"`
+	Port              int         `yaml:"port" validate:"required"`
+	BufferCapacity    uint64      `yaml:"buffer-capacity" validate:"required"`
+	EvictionPolicy    string      `yaml:"eviction-policy" validate:"omitempty,oneof='lower-priority' 'lowest-priority' 'drop-oldest' 'drop-newest' 'reject' 'random-early-drop'"`
+	LeaseDuration     string      `yaml:"lease-duration" validate:"omitempty,positive_duration"`
+	SelectionStrategy string      `yaml:"selection-strategy" validate:"omitempty,oneof='round-robin' 'least-recently-used' 'random' 'least-cumulative-work' 'weighted'"`
+	Retry             RetryConfig `yaml:"retry"`
+}
+
+func (ic InnerConfig) GetLeaseDuration() time.Duration {
+	return parseDurationOr(ic.LeaseDuration, defaultLeaseDuration)
+}
+
+type RetryConfig struct {
+	MaxAttempts    int    `yaml:"max-attempts" validate:"omitempty,min=1"`
+	InitialBackoff string `yaml:"initial-backoff" validate:"omitempty,positive_duration"`
+	MaxBackoff     string `yaml:"max-backoff" validate:"omitempty,positive_duration"`
+}
+
+// GetRetryPolicy returns policy, by default incedents are sent only once
+func (rc RetryConfig) GetRetryPolicy() domain.RetryPolicy {
+	return domain.RetryPolicy{
+		MaxAttempts:    max(rc.MaxAttempts, 1),
+		InitialBackoff: parseDurationOr(rc.InitialBackoff, defaultInitialBackoff),
+		MaxBackoff:     parseDurationOr(rc.MaxBackoff, defaultMaxBackoff),
+	}
+}
+
+type MetricsConfig struct {
+	Enabled bool `yaml:"enabled"`
+	Port    int  `yaml:"port" validate:"required_if=Enabled true"`
+}
+
+// HttpConfig enables HTTP/JSON gateway served next to grpc
+type HttpConfig struct {
+	Enabled bool `yaml:"enabled"`
+	Port    int  `yaml:"port" validate:"required_if=Enabled true"`
+}
+
+// ReportConfig sets where reports are written, empty path disables them
+type ReportConfig struct {
+	Path string `yaml:"path"`
+}
+
+// StatisticsConfig sets precision of confidence intervals,
+// dispatcher stops by itself when accuracy is reached, zero accuracy disables it
+type StatisticsConfig struct {
+	Confidence    float64      `yaml:"confidence" validate:"omitempty,gt=0,lt=1"`
+	Accuracy      float64      `yaml:"accuracy" validate:"omitempty,gt=0,lt=1"`
+	CheckInterval string       `yaml:"check-interval" validate:"omitempty,positive_duration"`
+	WarmUp        WarmUpConfig `yaml:"warm-up"`
+}
+
+func (sc StatisticsConfig) GetPrecision() domain.Precision {
+	confidence := sc.Confidence
+	if confidence == 0 {
+		confidence = defaultConfidence
+	}
+
+	return domain.Precision{Confidence: confidence, Accuracy: sc.Accuracy}
+}
+
+func (sc StatisticsConfig) GetCheckInterval() time.Duration {
+	return parseDurationOr(sc.CheckInterval, defaultCheckInterval)
+}
+
+// WarmUpConfig sets start-up period excluded from statistics, by time or by number of incedents
+type WarmUpConfig struct {
+	Duration  string `yaml:"duration" validate:"excluded_with=Incedents,omitempty,positive_duration"`
+	Incedents int    `yaml:"incedents" validate:"omitempty,min=1"`
+}
+
+func (wc WarmUpConfig) GetWarmUp() domain.WarmUp {
+	return domain.WarmUp{
+		Duration:  parseDurationOr(wc.Duration, 0),
+		Incedents: wc.Incedents,
+	}
+}
+
+// JournalConfig enables step-by-step journal of events, at least Capacity
+// last events are kept in memory, they are dumped to Path in jsonl if it is set
+type JournalConfig struct {
+	Enabled  bool   `yaml:"enabled"`
+	Capacity int    `yaml:"capacity" validate:"omitempty,min=1"`
+	Path     string `yaml:"path"`
+}
+
+func (jc JournalConfig) GetCapacity() int {
+	if jc.Capacity == 0 {
+		return defaultJournalEvents
+	}
+
+	return jc.Capacity
+}
+
+type PersistenceConfig struct {
+	Enabled            bool   `yaml:"enabled"`
+	Path               string `yaml:"path" validate:"required_if=Enabled true"`
+	Sync               bool   `yaml:"sync"`
+	CompactionInterval string `yaml:"compaction-interval" validate:"required_if=Enabled true,omitempty,positive_duration"`
+}
+
+func (pc PersistenceConfig) GetCompactionInterval() time.Duration {
+	interval, err := time.ParseDuration(pc.CompactionInterval)
+	if err != nil {
+		panic(err)
+	}
+
+	return interval
+}
+
+func parseDurationOr(value string, defaultValue time.Duration) time.Duration {
+	if value == "" {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return duration
 }
@@ -0,0 +1,164 @@
+package config
+
+import (
+	"time"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	common_config "github.com/PonomarevAlexxander/queuing-system/utils/config"
+	"github.com/PonomarevAlexxander/queuing-system/utils/scheduler"
+)
+
+// SimulationConfig describes single-process run of producers, dispatcher and processors in virtual time
+type SimulationConfig struct {
+	common_config.CommonConfig `yaml:",inline"`
+	// Duration is virtual time of arrivals, incedents left after it are processed
+	Duration string `yaml:"duration" validate:"required_without=Incedents,omitempty,positive_duration"`
+	// Incedents limits number of generated incedents, run ends when they are processed
+	Incedents         int                        `yaml:"incedents" validate:"omitempty,min=1"`
+	BufferCapacity    uint64                     `yaml:"buffer-capacity" validate:"required"`
+	EvictionPolicy    string                     `yaml:"eviction-policy" validate:"omitempty,oneof='lower-priority' 'lowest-priority' 'drop-oldest' 'drop-newest' 'reject' 'random-early-drop'"`
+	SelectionStrategy string                     `yaml:"selection-strategy" validate:"omitempty,oneof='round-robin' 'least-recently-used' 'random' 'least-cumulative-work' 'weighted'"`
+	Producers         []SimulatedProducerConfig  `yaml:"producers" validate:"required,min=1,unique=Priority,dive"`
+	Processors        []SimulatedProcessorConfig `yaml:"processors" validate:"required,min=1,unique=Id,dive"`
+	Report            ReportConfig               `yaml:"report"`
+	// Statistics sets precision of reported intervals, run length is set by Duration and Incedents
+	Statistics StatisticsConfig `yaml:"statistics"`
+	// Journal is dumped after the run, only kept events are written
+	Journal JournalConfig `yaml:"journal"`
+}
+
+// GetDuration returns zero if duration isn't limited
+func (sc SimulationConfig) GetDuration() time.Duration {
+	return parseDurationOr(sc.Duration, 0)
+}
+
+// WithPoint returns copy of the config with swept parameters, zero values of the point are left as is.
+// Interval of the point is set to every producer, it is mean only of distributions taking mean from
+// the interval. Changed number of processors is made of copies of the first one, so processors must
+// be alike, SweepConfig.CheckBase rejects configs breaking both rules
+func (sc SimulationConfig) WithPoint(point domain.SweepPoint) SimulationConfig {
+	if point.BufferCapacity > 0 {
+		sc.BufferCapacity = point.BufferCapacity
+	}
+	if point.ProducerInterval > 0 {
+		producers := make([]SimulatedProducerConfig, 0, len(sc.Producers))
+		for _, producer := range sc.Producers {
+			producer.Interval = point.ProducerInterval.String()
+			producers = append(producers, producer)
+		}
+		sc.Producers = producers
+	}
+	if point.Processors > 0 && point.Processors != len(sc.Processors) {
+		template := sc.Processors[0]
+		processors := make([]SimulatedProcessorConfig, 0, point.Processors)
+		for i := range point.Processors {
+			processor := template
+			processor.Id = uint64(i + 1)
+			// processors don't share random stream
+			if processor.ServiceTime.Seed != 0 {
+				processor.ServiceTime.Seed += uint64(i)
+			}
+			processors = append(processors, processor)
+		}
+		sc.Processors = processors
+	}
+
+	return sc
+}
+
+// GetMarkovModel returns M/M/n/K model of the configured system,
+// service rate is averaged over processor slots
+func (sc SimulationConfig) GetMarkovModel() domain.MarkovModel {
+	model := domain.MarkovModel{Capacity: int(sc.BufferCapacity)}
+	for _, producer := range sc.Producers {
+		model.ArrivalRate += 1 / meanInterval(producer.GetInterval(), producer.Arrival).Seconds()
+	}
+	var rates float64
+	for _, processor := range sc.Processors {
+		slots := int(max(processor.Capacity, 1))
+		model.Servers += slots
+		rates += float64(slots) / meanInterval(processor.GetInterval(), processor.ServiceTime).Seconds()
+	}
+	model.ServiceRate = rates / float64(model.Servers)
+
+	return model
+}
+
+// IsMarkovian reports whether all intervals are exponential, otherwise the model is approximation
+func (sc SimulationConfig) IsMarkovian() bool {
+	for _, producer := range sc.Producers {
+		if producer.Arrival.Type != scheduler.ExponentialDistribution {
+			return false
+		}
+	}
+	for _, processor := range sc.Processors {
+		if processor.ServiceTime.Type != scheduler.ExponentialDistribution {
+			return false
+		}
+	}
+
+	return true
+}
+
+// meanInterval returns mean of the distribution, uniform and hyperexponential ones
+// don't take it from the interval, mean of empirical one is taken as the interval
+func meanInterval(interval time.Duration, distribution common_config.DistributionConfig) time.Duration {
+	switch distribution.Type {
+	case scheduler.UniformDistribution:
+		return (parseDurationOr(distribution.Min, 0) + parseDurationOr(distribution.Max, 0)) / 2
+	case scheduler.HyperexponentialDistribution:
+		var mean float64
+		for _, phase := range distribution.Phases {
+			mean += phase.Probability * float64(parseDurationOr(phase.Mean, 0))
+		}
+		return time.Duration(mean)
+	default:
+		return interval
+	}
+}
+
+// takesMean reports whether mean of the distribution is set by the interval
+func takesMean(distribution common_config.DistributionConfig) bool {
+	switch distribution.Type {
+	case scheduler.UniformDistribution, scheduler.HyperexponentialDistribution, scheduler.EmpiricalDistribution:
+		return false
+	default:
+		return true
+	}
+}
+
+// SimulatedProducerConfig mirrors producer service config, priorities must be unique
+type SimulatedProducerConfig struct {
+	Priority uint64 `yaml:"priority"`
+	// Interval is mean time between incedents
+	Interval string                           `yaml:"interval" validate:"required,positive_duration"`
+	Arrival  common_config.DistributionConfig `yaml:"arrival"`
+}
+
+func (pc SimulatedProducerConfig) GetInterval() time.Duration {
+	interval, err := time.ParseDuration(pc.Interval)
+	if err != nil {
+		panic(err)
+	}
+
+	return interval
+}
+
+// SimulatedProcessorConfig mirrors processing service config
+type SimulatedProcessorConfig struct {
+	Id uint64 `yaml:"id" validate:"required"`
+	// Interval is mean service time
+	Interval    string                           `yaml:"interval" validate:"required,positive_duration"`
+	ServiceTime common_config.DistributionConfig `yaml:"service-time"`
+	Weight      uint32                           `yaml:"weight"`
+	Capacity    uint32                           `yaml:"capacity"`
+}
+
+func (pc SimulatedProcessorConfig) GetInterval() time.Duration {
+	interval, err := time.ParseDuration(pc.Interval)
+	if err != nil {
+		panic(err)
+	}
+
+	return interval
+}
@@ -0,0 +1,125 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+)
+
+// SweepConfig lists parameters to try, every combination is simulated
+// with the simulation config as a base
+type SweepConfig struct {
+	// Processors and BufferCapacity are ranges, empty range keeps base value
+	Processors     RangeConfig `yaml:"processors"`
+	BufferCapacity RangeConfig `yaml:"buffer-capacity"`
+	// ProducerIntervals are mean intervals set to every producer
+	ProducerIntervals  []string   `yaml:"producer-intervals" validate:"dive,required,positive_duration"`
+	Stop               StopConfig `yaml:"stop" validate:"required"`
+	RejectionThreshold float64    `yaml:"rejection-threshold" validate:"gte=0,lte=1"`
+	// Results is a path to csv table with results of all combinations
+	Results string `yaml:"results" validate:"required"`
+}
+
+// RangeConfig is [From, To] with Step, step is 1 by default
+type RangeConfig struct {
+	From int `yaml:"from" validate:"omitempty,min=1"`
+	To   int `yaml:"to" validate:"gtefield=From"`
+	Step int `yaml:"step" validate:"omitempty,min=1"`
+}
+
+// Values returns nil for empty range
+func (rc RangeConfig) Values() []int {
+	if rc.From == 0 {
+		return nil
+	}
+	values := make([]int, 0)
+	for value := rc.From; value <= rc.To; value += max(rc.Step, 1) {
+		values = append(values, value)
+	}
+
+	return values
+}
+
+type StopConfig struct {
+	// Incedents is number of incedents of the first run
+	Incedents int `yaml:"incedents" validate:"required,min=1"`
+	// Confidence enables reruns until rejection probability has required accuracy
+	Confidence float64 `yaml:"confidence" validate:"omitempty,gt=0,lt=1"`
+	Accuracy   float64 `yaml:"accuracy" validate:"required_with=Confidence,omitempty,gt=0,lt=1"`
+	// MaxIncedents bounds reruns, small rejection probability requires too many incedents
+	MaxIncedents int `yaml:"max-incedents" validate:"required_with=Confidence,omitempty,gtefield=Incedents"`
+}
+
+func (sc StopConfig) GetStopRule() domain.StopRule {
+	return domain.StopRule{
+		Incedents:    sc.Incedents,
+		Confidence:   sc.Confidence,
+		Accuracy:     sc.Accuracy,
+		MaxIncedents: sc.MaxIncedents,
+	}
+}
+
+// CheckBase rejects base config which can't be swept: producer intervals are means only of
+// distributions taking mean from the interval, processors are copies of the first one
+func (sc SweepConfig) CheckBase(base SimulationConfig) error {
+	if len(sc.ProducerIntervals) > 0 {
+		for _, producer := range base.Producers {
+			if !takesMean(producer.Arrival) {
+				return fmt.Errorf("intervals of producer %d can't be swept, %s distribution doesn't take mean from interval",
+					producer.Priority, producer.Arrival.Type)
+			}
+		}
+	}
+	if len(sc.Processors.Values()) > 0 {
+		template := base.Processors[0]
+		for _, processor := range base.Processors[1:] {
+			id := processor.Id
+			// copies differ in id and random stream only
+			processor.Id = template.Id
+			processor.ServiceTime.Seed = template.ServiceTime.Seed
+			if !reflect.DeepEqual(processor, template) {
+				return fmt.Errorf("number of processors can't be swept, processor %d differs from the first one", id)
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetPoints returns combinations ordered by buffer capacity, producer interval and
+// number of processors, so the first point of a group meeting threshold has the fewest processors.
+// Parameters without range are taken from the base, zero interval keeps base intervals.
+func (sc SweepConfig) GetPoints(base SimulationConfig) []domain.SweepPoint {
+	capacities := sc.BufferCapacity.Values()
+	if len(capacities) == 0 {
+		capacities = []int{int(base.BufferCapacity)}
+	}
+	intervals := make([]time.Duration, 0, len(sc.ProducerIntervals))
+	for _, interval := range sc.ProducerIntervals {
+		intervals = append(intervals, parseDurationOr(interval, 0))
+	}
+	if len(intervals) == 0 {
+		intervals = []time.Duration{0}
+	}
+	processors := sc.Processors.Values()
+	if len(processors) == 0 {
+		processors = []int{len(base.Processors)}
+	}
+
+	points := make([]domain.SweepPoint, 0, len(capacities)*len(intervals)*len(processors))
+	for _, capacity := range capacities {
+		for _, interval := range intervals {
+			for _, number := range processors {
+				points = append(points, domain.SweepPoint{
+					Processors:       number,
+					BufferCapacity:   uint64(capacity),
+					ProducerInterval: interval,
+				})
+			}
+		}
+	}
+
+	return points
+}
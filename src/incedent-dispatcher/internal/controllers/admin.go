@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/messages/admin"
+	"github.com/PonomarevAlexxander/queuing-system/messages/common"
+	"github.com/PonomarevAlexxander/queuing-system/services/dispatcher_admin"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+type adminUC interface {
+	SetBufferCapacity(ctx context.Context, capacity uint64) ([]domain.Incedent, error)
+	SetEvictionPolicy(ctx context.Context, policy string) error
+	SetSelectionStrategy(ctx context.Context, strategy string) error
+	DrainProcessor(ctx context.Context, processorID uint64) error
+	DeregisterProcessor(ctx context.Context, processorID uint64) error
+	ListProcessors(ctx context.Context) []domain.ProcessorStatus
+	GetBuffer(ctx context.Context) domain.BufferState
+}
+
+type AdminController struct {
+	dispatcher_admin.UnimplementedDispatcherAdminServer
+	log     *logger.Logger
+	adminUC adminUC
+}
+
+func NewAdminController(log *logger.Logger, adminUC adminUC) *AdminController {
+	return &AdminController{
+		log:     log,
+		adminUC: adminUC,
+	}
+}
+
+func (ac *AdminController) SetBufferCapacity(ctx context.Context, req *admin.SetBufferCapacityReq) (*admin.SetBufferCapacityResp, error) {
+	resp := &admin.SetBufferCapacityResp{
+		Result: &common.Result{
+			Success: true,
+		},
+	}
+
+	evicted, err := ac.adminUC.SetBufferCapacity(ctx, req.GetCapacity())
+	if err != nil {
+		resp.Result.Success = false
+		resp.Result.Msg = err.Error()
+		return resp, nil
+	}
+	resp.Evicted = uint64(len(evicted))
+
+	return resp, nil
+}
+
+func (ac *AdminController) SetEvictionPolicy(ctx context.Context, req *admin.SetEvictionPolicyReq) (*admin.SetEvictionPolicyResp, error) {
+	return &admin.SetEvictionPolicyResp{
+		Result: toProtoResult(ac.adminUC.SetEvictionPolicy(ctx, req.GetPolicy())),
+	}, nil
+}
+
+func (ac *AdminController) SetSelectionStrategy(ctx context.Context, req *admin.SetSelectionStrategyReq) (*admin.SetSelectionStrategyResp, error) {
+	return &admin.SetSelectionStrategyResp{
+		Result: toProtoResult(ac.adminUC.SetSelectionStrategy(ctx, req.GetStrategy())),
+	}, nil
+}
+
+func (ac *AdminController) DrainProcessor(ctx context.Context, req *admin.DrainProcessorReq) (*admin.DrainProcessorResp, error) {
+	return &admin.DrainProcessorResp{
+		Result: toProtoResult(ac.adminUC.DrainProcessor(ctx, req.GetId())),
+	}, nil
+}
+
+func (ac *AdminController) DeregisterProcessor(ctx context.Context, req *admin.DeregisterProcessorReq) (*admin.DeregisterProcessorResp, error) {
+	return &admin.DeregisterProcessorResp{
+		Result: toProtoResult(ac.adminUC.DeregisterProcessor(ctx, req.GetId())),
+	}, nil
+}
+
+func (ac *AdminController) ListProcessors(ctx context.Context, _ *admin.ListProcessorsReq) (*admin.ListProcessorsResp, error) {
+	statuses := ac.adminUC.ListProcessors(ctx)
+	resp := &admin.ListProcessorsResp{
+		Processors: make([]*admin.ProcessorInfo, 0, len(statuses)),
+	}
+	for _, status := range statuses {
+		resp.Processors = append(resp.Processors, &admin.ProcessorInfo{
+			Id:       status.Processor.Id,
+			Host:     status.Processor.Host,
+			Weight:   status.Processor.Weight,
+			Capacity: status.Processor.Capacity,
+			Busy:     uint32(status.Busy),
+			Draining: status.Draining,
+		})
+	}
+
+	return resp, nil
+}
+
+func (ac *AdminController) GetBuffer(ctx context.Context, _ *admin.GetBufferReq) (*admin.GetBufferResp, error) {
+	state := ac.adminUC.GetBuffer(ctx)
+	resp := &admin.GetBufferResp{
+		Capacity:   uint64(state.Capacity),
+		Size:       uint64(state.Size),
+		Priorities: make([]*admin.PriorityBuffer, 0),
+	}
+	// incedents are ordered by priority, so each one continues the last group or starts a new one
+	for _, incedent := range state.Incedents {
+		last := len(resp.Priorities) - 1
+		if last < 0 || resp.Priorities[last].Priority != uint64(incedent.Priority) {
+			resp.Priorities = append(resp.Priorities, &admin.PriorityBuffer{Priority: uint64(incedent.Priority)})
+			last++
+		}
+		resp.Priorities[last].Incedents = append(resp.Priorities[last].Incedents, &admin.BufferedIncedent{
+			Id:   incedent.Id,
+			Time: timestamppb.New(incedent.CreationTime),
+		})
+	}
+
+	return resp, nil
+}
+
+func toProtoResult(err error) *common.Result {
+	if err != nil {
+		return &common.Result{
+			Success: false,
+			Msg:     err.Error(),
+		}
+	}
+
+	return &common.Result{
+		Success: true,
+	}
+}
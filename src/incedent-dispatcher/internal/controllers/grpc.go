@@ -2,39 +2,69 @@ package controllers
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
 	"github.com/PonomarevAlexxander/queuing-system/messages/common"
 	"github.com/PonomarevAlexxander/queuing-system/messages/incedent"
+	"github.com/PonomarevAlexxander/queuing-system/messages/journal"
 	"github.com/PonomarevAlexxander/queuing-system/messages/registration"
+	"github.com/PonomarevAlexxander/queuing-system/messages/statistics"
 	"github.com/PonomarevAlexxander/queuing-system/services/incedent_dispatcher"
 	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
 )
 
 type registerUC interface {
 	Register(ctx context.Context, processor domain.IncedentProcessor) error
+	Heartbeat(ctx context.Context, processorID uint64) error
+	Deregister(ctx context.Context, processorID uint64) error
 }
 
 type dispatcher interface {
 	NewIncedent(ctx context.Context, incedent domain.Incedent) error
+	SubmitIncedent(ctx context.Context, incedent domain.Incedent) (domain.Ticket, error)
+	GetIncedentStatus(ctx context.Context, ticket domain.Ticket) (domain.IncedentStatus, error)
+	WatchIncedent(ctx context.Context, ticket domain.Ticket) (<-chan domain.IncedentStatus, error)
+}
+
+type statisticsUC interface {
+	GetStatistics(ctx context.Context) domain.Report
+	WatchStatistics(ctx context.Context, interval time.Duration) <-chan domain.Report
+	ResetStatistics(ctx context.Context)
+}
+
+type journalUC interface {
+	WatchJournal(ctx context.Context, from uint64) (<-chan domain.JournalEvent, error)
 }
 
 type GrpcController struct {
 	incedent_dispatcher.UnimplementedIncedentDispatcherServer
-	log        *logger.Logger
-	registerUC registerUC
-	dispatcher dispatcher
+	log          *logger.Logger
+	registerUC   registerUC
+	dispatcher   dispatcher
+	statisticsUC statisticsUC
+	journalUC    journalUC
 }
 
 func NewGrpcController(
 	log *logger.Logger,
 	registerUC registerUC,
 	dispatcherUC dispatcher,
+	statisticsUC statisticsUC,
+	journalUC journalUC,
 ) *GrpcController {
 	return &GrpcController{
-		log:        log,
-		registerUC: registerUC,
-		dispatcher: dispatcherUC,
+		log:          log,
+		registerUC:   registerUC,
+		dispatcher:   dispatcherUC,
+		statisticsUC: statisticsUC,
+		journalUC:    journalUC,
 	}
 }
 
@@ -45,22 +75,76 @@ func (gc *GrpcController) NewIncedent(ctx context.Context, req *incedent.NewInce
 		},
 	}
 
-	if err := gc.dispatcher.NewIncedent(
-		ctx,
-		domain.Incedent{
-			Id:           req.GetId(),
-			Priority:     domain.Priority(req.GetPriority()),
-			CreationTime: req.GetTime().AsTime(),
+	if err := gc.dispatcher.NewIncedent(ctx, toDomainIncedent(req)); err != nil {
+		resp.Result.Success = false
+		resp.Result.Msg = err.Error()
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+func (gc *GrpcController) SubmitIncedent(ctx context.Context, req *incedent.NewIncedentReq) (*incedent.SubmitIncedentResp, error) {
+	resp := &incedent.SubmitIncedentResp{
+		Result: &common.Result{
+			Success: true,
 		},
-	); err != nil {
+	}
+
+	ticket, err := gc.dispatcher.SubmitIncedent(ctx, toDomainIncedent(req))
+	if err != nil {
 		resp.Result.Success = false
 		resp.Result.Msg = err.Error()
 		return resp, nil
 	}
+	resp.Ticket = string(ticket)
 
 	return resp, nil
 }
 
+func (gc *GrpcController) GetIncedentStatus(ctx context.Context, req *incedent.IncedentStatusReq) (*incedent.IncedentStatusResp, error) {
+	resp := &incedent.IncedentStatusResp{
+		Result: &common.Result{
+			Success: true,
+		},
+	}
+
+	status, err := gc.dispatcher.GetIncedentStatus(ctx, domain.Ticket(req.GetTicket()))
+	if err != nil {
+		resp.Result.Success = false
+		resp.Result.Msg = err.Error()
+		return resp, nil
+	}
+	resp.Status = toProtoStatus(status)
+
+	return resp, nil
+}
+
+func (gc *GrpcController) WatchIncedent(req *incedent.IncedentStatusReq, stream incedent_dispatcher.IncedentDispatcher_WatchIncedentServer) error {
+	statuses, err := gc.dispatcher.WatchIncedent(stream.Context(), domain.Ticket(req.GetTicket()))
+	if err != nil {
+		return stream.Send(&incedent.IncedentStatusResp{
+			Result: &common.Result{
+				Success: false,
+				Msg:     err.Error(),
+			},
+		})
+	}
+
+	for status := range statuses {
+		if err := stream.Send(&incedent.IncedentStatusResp{
+			Result: &common.Result{
+				Success: true,
+			},
+			Status: toProtoStatus(status),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (gc *GrpcController) RegisterProcessor(ctx context.Context, req *registration.ProcessorRegisterReq) (*registration.ProcessorRegisterResp, error) {
 	resp := &registration.ProcessorRegisterResp{
 		Result: &common.Result{
@@ -70,7 +154,12 @@ func (gc *GrpcController) RegisterProcessor(ctx context.Context, req *registrati
 
 	if err := gc.registerUC.Register(
 		ctx,
-		domain.IncedentProcessor{Id: req.GetId(), Host: req.GetHost()},
+		domain.IncedentProcessor{
+			Id:       req.GetId(),
+			Host:     req.GetHost(),
+			Weight:   req.GetWeight(),
+			Capacity: req.GetCapacity(),
+		},
 	); err != nil {
 		resp.Result.Success = false
 		resp.Result.Msg = err.Error()
@@ -79,3 +168,226 @@ func (gc *GrpcController) RegisterProcessor(ctx context.Context, req *registrati
 
 	return resp, nil
 }
+
+func (gc *GrpcController) Heartbeat(ctx context.Context, req *registration.HeartbeatReq) (*registration.HeartbeatResp, error) {
+	resp := &registration.HeartbeatResp{
+		Result: &common.Result{
+			Success: true,
+		},
+	}
+
+	if err := gc.registerUC.Heartbeat(ctx, req.GetId()); err != nil {
+		resp.Result.Success = false
+		resp.Result.Msg = err.Error()
+		resp.Retired = errors.Is(err, domain.ErrProcessorRetired)
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+func (gc *GrpcController) DeregisterProcessor(ctx context.Context, req *registration.ProcessorDeregisterReq) (*registration.ProcessorDeregisterResp, error) {
+	resp := &registration.ProcessorDeregisterResp{
+		Result: &common.Result{
+			Success: true,
+		},
+	}
+
+	if err := gc.registerUC.Deregister(ctx, req.GetId()); err != nil {
+		resp.Result.Success = false
+		resp.Result.Msg = err.Error()
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+func (gc *GrpcController) GetStatistics(ctx context.Context, _ *statistics.StatisticsReq) (*statistics.StatisticsResp, error) {
+	return toProtoStatistics(gc.statisticsUC.GetStatistics(ctx)), nil
+}
+
+func (gc *GrpcController) WatchStatistics(req *statistics.WatchStatisticsReq, stream incedent_dispatcher.IncedentDispatcher_WatchStatisticsServer) error {
+	reports := gc.statisticsUC.WatchStatistics(stream.Context(), req.GetInterval().AsDuration())
+	for report := range reports {
+		if err := stream.Send(toProtoStatistics(report)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (gc *GrpcController) ResetStatistics(ctx context.Context, _ *statistics.ResetStatisticsReq) (*statistics.ResetStatisticsResp, error) {
+	gc.statisticsUC.ResetStatistics(ctx)
+
+	return &statistics.ResetStatisticsResp{
+		Result: &common.Result{
+			Success: true,
+		},
+	}, nil
+}
+
+func (gc *GrpcController) WatchJournal(req *journal.WatchJournalReq, stream incedent_dispatcher.IncedentDispatcher_WatchJournalServer) error {
+	events, err := gc.journalUC.WatchJournal(stream.Context(), req.GetFrom())
+	if errors.Is(err, domain.ErrJournalDisabled) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	for event := range events {
+		if err := stream.Send(toProtoJournalEvent(event)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func toDomainIncedent(req *incedent.NewIncedentReq) domain.Incedent {
+	return domain.Incedent{
+		Id:           req.GetId(),
+		Priority:     domain.Priority(req.GetPriority()),
+		CreationTime: req.GetTime().AsTime(),
+		Source:       req.GetSource(),
+		Category:     req.GetCategory(),
+		Labels:       req.GetLabels(),
+		Payload: domain.Payload{
+			ContentType: req.GetPayload().GetContentType(),
+			Data:        req.GetPayload().GetData(),
+		},
+	}
+}
+
+func toProtoStatus(status domain.IncedentStatus) incedent.IncedentStatus {
+	switch status {
+	case domain.InBuffer:
+		return incedent.IncedentStatus_IN_BUFFER
+	case domain.InProcessing:
+		return incedent.IncedentStatus_IN_PROCESSING
+	case domain.Processed:
+		return incedent.IncedentStatus_PROCESSED
+	case domain.Rejected:
+		return incedent.IncedentStatus_REJECTED
+	default:
+		return incedent.IncedentStatus_INCEDENT_STATUS_UNKNOWN
+	}
+}
+
+func toProtoStatistics(report domain.Report) *statistics.StatisticsResp {
+	resp := &statistics.StatisticsResp{
+		Result: &common.Result{
+			Success: true,
+		},
+		Time:       timestamppb.New(report.Time),
+		Since:      timestamppb.New(report.Since),
+		Priorities: make([]*statistics.PriorityStatistics, 0, len(report.Priorities)),
+		Processors: make([]*statistics.ProcessorStatistics, 0, len(report.Processors)),
+	}
+	for _, p := range report.Priorities {
+		resp.Priorities = append(resp.Priorities, &statistics.PriorityStatistics{
+			Priority:             uint64(p.Priority),
+			Total:                uint64(p.Total),
+			Processed:            uint64(p.Processed),
+			Rejected:             uint64(p.Rejected),
+			Evicted:              uint64(p.Evicted),
+			Retried:              uint64(p.Retried),
+			InProgress:           uint64(p.InProgress),
+			PRejected:            p.PRejected,
+			TimeInSystem:         durationpb.New(p.TimeInSystem),
+			TimeInBuffer:         toProtoTimeStats(p.TimeInBuffer),
+			TimeInProcessing:     toProtoTimeStats(p.TimeInProcessing),
+			PRejectedInterval:    toProtoInterval(p.PRejectedInterval),
+			TimeInSystemInterval: toProtoInterval(p.TimeInSystemInterval),
+			RequiredIncedents:    uint64(p.RequiredIncedents),
+		})
+	}
+	for _, p := range report.Processors {
+		resp.Processors = append(resp.Processors, &statistics.ProcessorStatistics{
+			Id:             p.Id,
+			Capacity:       uint32(p.Capacity),
+			RegisteredAt:   timestamppb.New(p.RegTime),
+			EndAt:          timestamppb.New(p.EndTime),
+			Deregistered:   p.Deregistered,
+			LeaseExpired:   p.LeaseExpired,
+			Uptime:         durationpb.New(p.Uptime),
+			InWork:         durationpb.New(p.InWork),
+			FailedAttempts: uint64(p.FailedAttempts),
+			Utilization:    p.Utilization,
+		})
+	}
+
+	return resp
+}
+
+func toProtoTimeStats(stats domain.TimeStats) *statistics.TimeStats {
+	return &statistics.TimeStats{
+		Mean:     durationpb.New(stats.Mean),
+		Variance: stats.Variance,
+	}
+}
+
+func toProtoInterval(interval domain.ConfidenceInterval) *statistics.ConfidenceInterval {
+	return &statistics.ConfidenceInterval{
+		Mean:      interval.Mean,
+		HalfWidth: interval.HalfWidth,
+		Samples:   uint64(interval.Samples),
+	}
+}
+
+func toProtoJournalEvent(event domain.JournalEvent) *journal.JournalEvent {
+	resp := &journal.JournalEvent{
+		Seq:         event.Seq,
+		Time:        timestamppb.New(event.Time),
+		Type:        toProtoEventType(event.Type),
+		Incedent:    toProtoJournalIncedent(event.Incedent),
+		ProcessorId: event.ProcessorID,
+		Buffer:      make([]*journal.Incedent, 0, len(event.Buffer)),
+		Processors:  make([]*journal.ProcessorState, 0, len(event.Processors)),
+	}
+	for _, incedent := range event.Buffer {
+		resp.Buffer = append(resp.Buffer, toProtoJournalIncedent(incedent))
+	}
+	for _, processor := range event.Processors {
+		state := &journal.ProcessorState{
+			Id:        processor.Id,
+			Incedents: make([]*journal.Incedent, 0, len(processor.Incedents)),
+		}
+		for _, incedent := range processor.Incedents {
+			state.Incedents = append(state.Incedents, toProtoJournalIncedent(incedent))
+		}
+		resp.Processors = append(resp.Processors, state)
+	}
+
+	return resp
+}
+
+func toProtoJournalIncedent(incedent domain.Incedent) *journal.Incedent {
+	return &journal.Incedent{
+		Id:       incedent.Id,
+		Priority: uint64(incedent.Priority),
+		Time:     timestamppb.New(incedent.CreationTime),
+	}
+}
+
+func toProtoEventType(eventType domain.EventType) journal.EventType {
+	switch eventType {
+	case domain.EventArrived:
+		return journal.EventType_ARRIVED
+	case domain.EventBuffered:
+		return journal.EventType_BUFFERED
+	case domain.EventEvicted:
+		return journal.EventType_EVICTED
+	case domain.EventStarted:
+		return journal.EventType_STARTED
+	case domain.EventAttemptFailed:
+		return journal.EventType_ATTEMPT_FAILED
+	case domain.EventFinished:
+		return journal.EventType_FINISHED
+	case domain.EventRejected:
+		return journal.EventType_REJECTED
+	default:
+		return journal.EventType_EVENT_TYPE_UNKNOWN
+	}
+}
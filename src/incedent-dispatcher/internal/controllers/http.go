@@ -0,0 +1,293 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+const (
+	syncMode  = "sync"
+	asyncMode = "async"
+)
+
+type processorsUC interface {
+	ListProcessors(ctx context.Context) []domain.ProcessorStatus
+}
+
+// resultJSON mirrors common.Result, failed result is sent with 200 status like over grpc,
+// other statuses mean that request itself is wrong
+type resultJSON struct {
+	Success bool   `json:"success"`
+	Msg     string `json:"msg,omitempty"`
+}
+
+type newIncedentJSON struct {
+	Id       *uint64 `json:"id"`
+	Priority *uint64 `json:"priority"`
+	// Time is creation time, now by default
+	Time     *time.Time        `json:"time"`
+	Source   string            `json:"source"`
+	Category string            `json:"category"`
+	Labels   map[string]string `json:"labels"`
+	Payload  payloadJSON       `json:"payload"`
+}
+
+type payloadJSON struct {
+	ContentType string `json:"content_type"`
+	// Data is base64 encoded
+	Data []byte `json:"data"`
+}
+
+type incedentRespJSON struct {
+	Result resultJSON `json:"result"`
+	Ticket string     `json:"ticket,omitempty"`
+	Status string     `json:"status,omitempty"`
+}
+
+type processorJSON struct {
+	Id       uint64 `json:"id"`
+	Host     string `json:"host"`
+	Weight   uint32 `json:"weight"`
+	Capacity uint32 `json:"capacity"`
+	Busy     int    `json:"busy"`
+	Draining bool   `json:"draining"`
+}
+
+type priorityStatsJSON struct {
+	Priority     uint64  `json:"priority"`
+	Total        int     `json:"total"`
+	Processed    int     `json:"processed"`
+	Rejected     int     `json:"rejected"`
+	Evicted      int     `json:"evicted"`
+	Retried      int     `json:"retried"`
+	InProgress   int     `json:"in_progress"`
+	PRejected    float64 `json:"p_rejected"`
+	TimeInSystem float64 `json:"time_in_system_sec"`
+	TimeInBuffer float64 `json:"time_in_buffer_sec"`
+}
+
+type processorStatsJSON struct {
+	Id             uint64  `json:"id"`
+	Capacity       int     `json:"capacity"`
+	Deregistered   bool    `json:"deregistered"`
+	Uptime         float64 `json:"uptime_sec"`
+	InWork         float64 `json:"in_work_sec"`
+	FailedAttempts int     `json:"failed_attempts"`
+	Utilization    float64 `json:"utilization"`
+}
+
+type statsJSON struct {
+	Time       time.Time            `json:"time"`
+	Since      time.Time            `json:"since"`
+	Priorities []priorityStatsJSON  `json:"priorities"`
+	Processors []processorStatsJSON `json:"processors"`
+}
+
+// HttpController serves incedents, processors and statistics over HTTP/JSON
+// for producers which can't use grpc
+type HttpController struct {
+	log          *logger.Logger
+	clk          clock.Clock
+	dispatcher   dispatcher
+	processorsUC processorsUC
+	statisticsUC statisticsUC
+	server       *http.Server
+}
+
+func NewHttpController(
+	log *logger.Logger,
+	clk clock.Clock,
+	port int,
+	dispatcherUC dispatcher,
+	processorsUC processorsUC,
+	statisticsUC statisticsUC,
+) *HttpController {
+	hc := &HttpController{
+		log:          log,
+		clk:          clk,
+		dispatcher:   dispatcherUC,
+		processorsUC: processorsUC,
+		statisticsUC: statisticsUC,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /incidents", hc.newIncedent)
+	mux.HandleFunc("GET /incidents/{ticket}", hc.incedentStatus)
+	mux.HandleFunc("GET /processors", hc.processors)
+	mux.HandleFunc("GET /stats", hc.statistics)
+	hc.server = &http.Server{
+		Addr:    fmt.Sprintf("localhost:%d", port),
+		Handler: mux,
+	}
+
+	return hc
+}
+
+func (hc *HttpController) Run(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() {
+		if err := hc.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-errCh:
+		return fmt.Errorf("http server failed: %w", err)
+	}
+}
+
+func (hc *HttpController) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := hc.server.Shutdown(ctx); err != nil {
+		hc.log.Error("Failed to stop http server", zap.Error(err))
+	}
+}
+
+// newIncedent waits for the result in sync mode (default) and returns ticket in async one
+func (hc *HttpController) newIncedent(w http.ResponseWriter, r *http.Request) {
+	mode := r.URL.Query().Get("mode")
+	if mode == "" {
+		mode = syncMode
+	}
+	if mode != syncMode && mode != asyncMode {
+		hc.writeError(w, http.StatusBadRequest, fmt.Errorf("unknown mode '%s'", mode))
+		return
+	}
+	var req newIncedentJSON
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		hc.writeError(w, http.StatusBadRequest, fmt.Errorf("malformed incedent: %w", err))
+		return
+	}
+	if req.Id == nil || req.Priority == nil {
+		hc.writeError(w, http.StatusBadRequest, errors.New("id and priority are required"))
+		return
+	}
+	incedent := domain.Incedent{
+		Id:           *req.Id,
+		Priority:     domain.Priority(*req.Priority),
+		CreationTime: hc.clk.Now(),
+		Source:       req.Source,
+		Category:     req.Category,
+		Labels:       req.Labels,
+		Payload: domain.Payload{
+			ContentType: req.Payload.ContentType,
+			Data:        req.Payload.Data,
+		},
+	}
+	if req.Time != nil {
+		incedent.CreationTime = *req.Time
+	}
+
+	var resp incedentRespJSON
+	if mode == syncMode {
+		resp.Result = toResultJSON(hc.dispatcher.NewIncedent(r.Context(), incedent))
+	} else {
+		ticket, err := hc.dispatcher.SubmitIncedent(r.Context(), incedent)
+		resp.Result = toResultJSON(err)
+		resp.Ticket = string(ticket)
+	}
+	hc.writeJSON(w, http.StatusOK, resp)
+}
+
+func (hc *HttpController) incedentStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := hc.dispatcher.GetIncedentStatus(r.Context(), domain.Ticket(r.PathValue("ticket")))
+	switch {
+	case errors.Is(err, domain.ErrBadTicket):
+		hc.writeError(w, http.StatusBadRequest, err)
+	case errors.Is(err, domain.ErrUnknownIncedent):
+		hc.writeError(w, http.StatusNotFound, err)
+	case err != nil:
+		hc.writeError(w, http.StatusInternalServerError, err)
+	default:
+		hc.writeJSON(w, http.StatusOK, incedentRespJSON{
+			Result: toResultJSON(nil),
+			Status: status.String(),
+		})
+	}
+}
+
+func (hc *HttpController) processors(w http.ResponseWriter, r *http.Request) {
+	statuses := hc.processorsUC.ListProcessors(r.Context())
+	processors := make([]processorJSON, 0, len(statuses))
+	for _, status := range statuses {
+		processors = append(processors, processorJSON{
+			Id:       status.Processor.Id,
+			Host:     status.Processor.Host,
+			Weight:   status.Processor.Weight,
+			Capacity: max(status.Processor.Capacity, 1),
+			Busy:     status.Busy,
+			Draining: status.Draining,
+		})
+	}
+	hc.writeJSON(w, http.StatusOK, map[string][]processorJSON{"processors": processors})
+}
+
+func (hc *HttpController) statistics(w http.ResponseWriter, r *http.Request) {
+	report := hc.statisticsUC.GetStatistics(r.Context())
+	stats := statsJSON{
+		Time:       report.Time,
+		Since:      report.Since,
+		Priorities: make([]priorityStatsJSON, 0, len(report.Priorities)),
+		Processors: make([]processorStatsJSON, 0, len(report.Processors)),
+	}
+	for _, p := range report.Priorities {
+		stats.Priorities = append(stats.Priorities, priorityStatsJSON{
+			Priority:     uint64(p.Priority),
+			Total:        p.Total,
+			Processed:    p.Processed,
+			Rejected:     p.Rejected,
+			Evicted:      p.Evicted,
+			Retried:      p.Retried,
+			InProgress:   p.InProgress,
+			PRejected:    p.PRejected,
+			TimeInSystem: p.TimeInSystem.Seconds(),
+			TimeInBuffer: p.TimeInBuffer.Mean.Seconds(),
+		})
+	}
+	for _, p := range report.Processors {
+		stats.Processors = append(stats.Processors, processorStatsJSON{
+			Id:             p.Id,
+			Capacity:       p.Capacity,
+			Deregistered:   p.Deregistered,
+			Uptime:         p.Uptime.Seconds(),
+			InWork:         p.InWork.Seconds(),
+			FailedAttempts: p.FailedAttempts,
+			Utilization:    p.Utilization,
+		})
+	}
+	hc.writeJSON(w, http.StatusOK, stats)
+}
+
+func (hc *HttpController) writeError(w http.ResponseWriter, code int, err error) {
+	hc.writeJSON(w, code, incedentRespJSON{Result: toResultJSON(err)})
+}
+
+func (hc *HttpController) writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		hc.log.Warn("Failed to write http response", zap.Error(err))
+	}
+}
+
+func toResultJSON(err error) resultJSON {
+	if err != nil {
+		return resultJSON{Msg: err.Error()}
+	}
+
+	return resultJSON{Success: true}
+}
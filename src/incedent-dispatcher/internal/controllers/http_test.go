@@ -0,0 +1,167 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+func TestControllers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Controllers Suite")
+}
+
+var testTickets = domain.NewTicketSigner([]byte("test"))
+
+type fakeDispatcher struct {
+	received []domain.Incedent
+	err      error
+}
+
+func (f *fakeDispatcher) NewIncedent(_ context.Context, incedent domain.Incedent) error {
+	f.received = append(f.received, incedent)
+	return f.err
+}
+
+func (f *fakeDispatcher) SubmitIncedent(_ context.Context, incedent domain.Incedent) (domain.Ticket, error) {
+	f.received = append(f.received, incedent)
+	if f.err != nil {
+		return "", f.err
+	}
+	return testTickets.NewTicket(incedent), nil
+}
+
+func (f *fakeDispatcher) GetIncedentStatus(_ context.Context, ticket domain.Ticket) (domain.IncedentStatus, error) {
+	priority, id, err := testTickets.ParseTicket(ticket)
+	if err != nil {
+		return 0, err
+	}
+	if priority != 1 || id != 7 {
+		return 0, domain.ErrUnknownIncedent
+	}
+	return domain.InProcessing, nil
+}
+
+func (f *fakeDispatcher) WatchIncedent(context.Context, domain.Ticket) (<-chan domain.IncedentStatus, error) {
+	return nil, nil
+}
+
+type fakeProcessors []domain.ProcessorStatus
+
+func (f fakeProcessors) ListProcessors(context.Context) []domain.ProcessorStatus {
+	return f
+}
+
+type fakeStatistics domain.Report
+
+func (f fakeStatistics) GetStatistics(context.Context) domain.Report {
+	return domain.Report(f)
+}
+
+func (f fakeStatistics) WatchStatistics(context.Context, time.Duration) <-chan domain.Report {
+	return nil
+}
+
+func (f fakeStatistics) ResetStatistics(context.Context) {}
+
+var _ = Describe("HttpController", func() {
+	var (
+		clk        *clock.Mock
+		dispatcher *fakeDispatcher
+		handler    http.Handler
+	)
+
+	BeforeEach(func() {
+		clk = clock.NewMock()
+		dispatcher = &fakeDispatcher{}
+		processors := fakeProcessors{{Processor: domain.IncedentProcessor{Id: 3, Host: "localhost:8090"}, Busy: 1}}
+		statistics := fakeStatistics{Priorities: []domain.PriorityReport{{Priority: 1, Total: 2, Processed: 1}}}
+		handler = NewHttpController(logger.InitZapWrapper(zap.NewNop()), clk, 0,
+			dispatcher, processors, statistics).server.Handler
+	})
+
+	do := func(method, target, body string, out any) int {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(method, target, strings.NewReader(body)))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+		Expect(json.Unmarshal(recorder.Body.Bytes(), out)).To(Succeed())
+		return recorder.Code
+	}
+
+	It("Submits incedents in sync and async modes", func() {
+		var resp incedentRespJSON
+		Expect(do(http.MethodPost, "/incidents", `{"id": 7, "priority": 1}`, &resp)).To(Equal(http.StatusOK))
+		Expect(resp).To(Equal(incedentRespJSON{Result: resultJSON{Success: true}}))
+		Expect(dispatcher.received[0]).To(Equal(domain.Incedent{Id: 7, Priority: 1, CreationTime: clk.Now()}))
+
+		resp = incedentRespJSON{}
+		Expect(do(http.MethodPost, "/incidents?mode=async", `{"id": 8, "priority": 2}`, &resp)).To(Equal(http.StatusOK))
+		Expect(resp.Ticket).To(Equal(string(testTickets.NewTicket(domain.Incedent{Id: 8, Priority: 2}))))
+
+		dispatcher.err = fmt.Errorf("buffer is full")
+		resp = incedentRespJSON{}
+		Expect(do(http.MethodPost, "/incidents", `{"id": 9, "priority": 2}`, &resp)).To(Equal(http.StatusOK))
+		Expect(resp.Result).To(Equal(resultJSON{Msg: "buffer is full"}))
+	})
+
+	It("Passes incedent metadata", func() {
+		var resp incedentRespJSON
+		body := `{"id": 7, "priority": 1, "source": "producer-1", "category": "network",
+			"labels": {"region": "eu"}, "payload": {"content_type": "text/plain", "data": "aGVsbG8="}}`
+		Expect(do(http.MethodPost, "/incidents", body, &resp)).To(Equal(http.StatusOK))
+		Expect(dispatcher.received[0]).To(Equal(domain.Incedent{
+			Id:           7,
+			Priority:     1,
+			CreationTime: clk.Now(),
+			Source:       "producer-1",
+			Category:     "network",
+			Labels:       map[string]string{"region": "eu"},
+			Payload:      domain.Payload{ContentType: "text/plain", Data: []byte("hello")},
+		}))
+	})
+
+	It("Rejects malformed requests", func() {
+		var resp incedentRespJSON
+		Expect(do(http.MethodPost, "/incidents", `{"id": 7}`, &resp)).To(Equal(http.StatusBadRequest))
+		Expect(resp.Result.Success).To(BeFalse())
+		Expect(do(http.MethodPost, "/incidents", `not json`, &resp)).To(Equal(http.StatusBadRequest))
+		Expect(do(http.MethodPost, "/incidents?mode=later", `{"id": 7, "priority": 1}`, &resp)).To(Equal(http.StatusBadRequest))
+		Expect(dispatcher.received).To(BeEmpty())
+	})
+
+	It("Returns incedent status by ticket", func() {
+		var resp incedentRespJSON
+		ticket := func(id uint64) string {
+			return string(testTickets.NewTicket(domain.Incedent{Id: id, Priority: 1}))
+		}
+		Expect(do(http.MethodGet, "/incidents/"+ticket(7), "", &resp)).To(Equal(http.StatusOK))
+		Expect(resp.Status).To(Equal("InProcessing"))
+		Expect(do(http.MethodGet, "/incidents/"+ticket(8), "", &resp)).To(Equal(http.StatusNotFound))
+		// ticket can't be made from priority and id only
+		Expect(do(http.MethodGet, "/incidents/1-7-0000000000000000", "", &resp)).To(Equal(http.StatusNotFound))
+		Expect(do(http.MethodGet, "/incidents/bad", "", &resp)).To(Equal(http.StatusBadRequest))
+	})
+
+	It("Lists processors and statistics", func() {
+		var processors map[string][]processorJSON
+		Expect(do(http.MethodGet, "/processors", "", &processors)).To(Equal(http.StatusOK))
+		Expect(processors["processors"]).To(Equal([]processorJSON{{Id: 3, Host: "localhost:8090", Capacity: 1, Busy: 1}}))
+
+		var stats statsJSON
+		Expect(do(http.MethodGet, "/stats", "", &stats)).To(Equal(http.StatusOK))
+		Expect(stats.Priorities).To(Equal([]priorityStatsJSON{{Priority: 1, Total: 2, Processed: 1}}))
+	})
+})
@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+// MetricsController serves prometheus metrics on /metrics
+type MetricsController struct {
+	log    *logger.Logger
+	server *http.Server
+}
+
+func NewMetricsController(log *logger.Logger, port int, gatherer prometheus.Gatherer) *MetricsController {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
+
+	return &MetricsController{
+		log: log,
+		server: &http.Server{
+			Addr:    fmt.Sprintf("localhost:%d", port),
+			Handler: mux,
+		},
+	}
+}
+
+func (mc *MetricsController) Run(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() {
+		if err := mc.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-errCh:
+		return fmt.Errorf("metrics server failed: %w", err)
+	}
+}
+
+func (mc *MetricsController) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := mc.server.Shutdown(ctx); err != nil {
+		mc.log.Error("Failed to stop metrics server", zap.Error(err))
+	}
+}
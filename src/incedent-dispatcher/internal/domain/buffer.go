@@ -0,0 +1,10 @@
+package domain
+
+// BufferState is a snapshot of the buffer, Incedents are ordered
+// from the highest priority and the oldest incedent
+type BufferState struct {
+	Capacity int
+	// Size includes incedents taken for processing which still occupy the buffer
+	Size      int
+	Incedents []Incedent
+}
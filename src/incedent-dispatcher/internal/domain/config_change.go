@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// settings which can be changed without restart
+const (
+	SettingBufferCapacity      = "buffer-capacity"
+	SettingEvictionPolicy      = "eviction-policy"
+	SettingSelectionStrategy   = "selection-strategy"
+	SettingDrainProcessor      = "drain-processor"
+	SettingDeregisterProcessor = "deregister-processor"
+)
+
+// ConfigChange is a runtime change of dispatcher settings
+type ConfigChange struct {
+	Time    time.Time
+	Setting string
+	Value   string
+}
+
+func (c ConfigChange) String() string {
+	return fmt.Sprintf("ConfigChange{%v=%v}", c.Setting, c.Value)
+}
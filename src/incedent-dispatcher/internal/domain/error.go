@@ -3,5 +3,12 @@ package domain
 import "errors"
 
 var (
-	ErrBadResult = errors.New("response had bad result")
+	ErrBadResult        = errors.New("response had bad result")
+	ErrTransport        = errors.New("transport failure")
+	ErrBadTicket        = errors.New("ticket is malformed")
+	ErrUnknownIncedent  = errors.New("incedent is unknown")
+	ErrUnknownProcessor = errors.New("processor is unknown")
+	ErrProcessorRetired = errors.New("processor was deregistered by admin")
+	ErrBadSetting       = errors.New("setting value is invalid")
+	ErrJournalDisabled  = errors.New("journal is disabled")
 )
@@ -11,8 +11,53 @@ type Incedent struct {
 	Id           uint64
 	CreationTime time.Time
 	Priority     Priority
+	// Source is id of the producer
+	Source   string
+	Category string
+	Labels   map[string]string
+	Payload  Payload
 }
 
 func (i Incedent) String() string {
-	return fmt.Sprintf("Incedent{%v, %v, %v}", i.Id, i.CreationTime, i.Priority)
+	return fmt.Sprintf("Incedent{%v, %v, %v, %q, %q, %v, %v}",
+		i.Id, i.CreationTime, i.Priority, i.Source, i.Category, i.Labels, i.Payload)
+}
+
+type Payload struct {
+	ContentType string
+	Data        []byte
+}
+
+// String doesn't print data, it can be large and binary
+func (p Payload) String() string {
+	return fmt.Sprintf("Payload{%q, %d bytes}", p.ContentType, len(p.Data))
+}
+
+type IncedentStatus int
+
+const (
+	InBuffer IncedentStatus = iota
+	InProcessing
+	Processed
+	Rejected
+)
+
+func (s IncedentStatus) String() string {
+	switch s {
+	case InBuffer:
+		return "InBuffer"
+	case InProcessing:
+		return "InProcessing"
+	case Processed:
+		return "Processed"
+	case Rejected:
+		return "Rejected"
+	default:
+		return fmt.Sprintf("IncedentStatus(%d)", int(s))
+	}
+}
+
+// IsFinal reports whether incedent status can't change anymore
+func (s IncedentStatus) IsFinal() bool {
+	return s == Processed || s == Rejected
 }
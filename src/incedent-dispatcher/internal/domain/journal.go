@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type EventType int
+
+// handled incedent ends with one of evicted, finished or rejected events
+const (
+	EventArrived EventType = iota + 1
+	EventBuffered
+	// EventEvicted is incedent pushed out of the buffer or refused by eviction policy
+	EventEvicted
+	EventStarted
+	EventAttemptFailed
+	EventFinished
+	// EventRejected is incedent failed for other reasons than eviction
+	EventRejected
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventArrived:
+		return "arrived"
+	case EventBuffered:
+		return "buffered"
+	case EventEvicted:
+		return "evicted"
+	case EventStarted:
+		return "started"
+	case EventAttemptFailed:
+		return "attempt-failed"
+	case EventFinished:
+		return "finished"
+	case EventRejected:
+		return "rejected"
+	default:
+		return fmt.Sprintf("EventType(%d)", int(t))
+	}
+}
+
+// ProcessorState is processor with incedents it works on
+type ProcessorState struct {
+	Id        uint64
+	Incedents []Incedent
+}
+
+// JournalEvent is one step of the dispatcher, Buffer and Processors are state after the event
+type JournalEvent struct {
+	// Seq numbers events from 1 without gaps
+	Seq      uint64
+	Time     time.Time
+	Type     EventType
+	Incedent Incedent
+	// ProcessorID is set for started, attempt-failed and finished events
+	ProcessorID uint64
+	// Buffer is ordered by priority from the highest, then by creation time
+	Buffer []Incedent
+	// Processors are ordered by id, idle processors are kept once they worked
+	Processors []ProcessorState
+}
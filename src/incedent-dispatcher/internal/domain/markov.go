@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// MarkovModel is M/M/n/K queue with poisson arrivals and exponential service.
+// Incedents in processing keep their place in the buffer, so buffer capacity
+// is the capacity K of the whole system.
+// Wait time matches 'reject' eviction policy only, evicting policies push out
+// incedents which waited the longest. With several slots the dispatcher
+// waits for the whole packet, so measured wait is longer than the model one.
+type MarkovModel struct {
+	// ArrivalRate is total number of incedents per second
+	ArrivalRate float64
+	// ServiceRate is number of incedents per second served by one slot
+	ServiceRate float64
+	// Servers is total number of processor slots
+	Servers  int
+	Capacity int
+}
+
+// ModelMetrics are stationary characteristics of the model
+type ModelMetrics struct {
+	PRejected    float64
+	MeanWait     time.Duration
+	TimeInSystem time.Duration
+	Utilization  float64
+}
+
+// Solve computes stationary probabilities, rejected incedents don't count in wait time
+func (m MarkovModel) Solve() ModelMetrics {
+	servers := min(m.Servers, m.Capacity)
+	if servers <= 0 || m.ArrivalRate <= 0 || m.ServiceRate <= 0 {
+		return ModelMetrics{}
+	}
+
+	// probabilities of k incedents in system up to a constant, p[k] = p[k-1] * λ / (min(k, n) * μ)
+	load := m.ArrivalRate / m.ServiceRate
+	probabilities := make([]float64, m.Capacity+1)
+	probabilities[0] = 1
+	var total float64 = 1
+	for k := 1; k <= m.Capacity; k++ {
+		probabilities[k] = probabilities[k-1] * load / float64(min(k, servers))
+		total += probabilities[k]
+	}
+
+	var queueLength float64
+	for k := range probabilities {
+		probabilities[k] /= total
+		queueLength += float64(max(k-servers, 0)) * probabilities[k]
+	}
+
+	pRejected := probabilities[m.Capacity]
+	throughput := m.ArrivalRate * (1 - pRejected)
+	meanWait := queueLength / throughput
+
+	return ModelMetrics{
+		PRejected:    pRejected,
+		MeanWait:     secondsToDuration(meanWait),
+		TimeInSystem: secondsToDuration(meanWait + 1/m.ServiceRate),
+		Utilization:  throughput / (float64(servers) * m.ServiceRate),
+	}
+}
+
+// ComparisonRow is theoretical and measured values of one metric
+type ComparisonRow struct {
+	Metric      string
+	Theoretical float64
+	Measured    float64
+}
+
+// RelativeError is |measured - theoretical| / theoretical
+func (r ComparisonRow) RelativeError() float64 {
+	if r.Theoretical == 0 {
+		if r.Measured == 0 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+
+	return math.Abs(r.Measured-r.Theoretical) / r.Theoretical
+}
+
+// CompareWithModel puts model metrics next to the report summary, times are in seconds
+func CompareWithModel(model ModelMetrics, report Report) []ComparisonRow {
+	summary := report.Summary()
+
+	return []ComparisonRow{
+		{Metric: "p_rejected", Theoretical: model.PRejected, Measured: summary.PRejected},
+		{Metric: "mean_wait_sec", Theoretical: model.MeanWait.Seconds(), Measured: summary.MeanWait.Seconds()},
+		{Metric: "time_in_system_sec", Theoretical: model.TimeInSystem.Seconds(), Measured: summary.TimeInSystem.Seconds()},
+		{Metric: "utilization", Theoretical: model.Utilization, Measured: summary.Utilization},
+	}
+}
@@ -0,0 +1,52 @@
+package domain_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+)
+
+func TestDomain(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Domain Suite")
+}
+
+var _ = Describe("MarkovModel", func() {
+	It("Matches M/M/1/K closed form", func() {
+		const rho, capacity = 0.5, 3
+		metrics := domain.MarkovModel{ArrivalRate: rho, ServiceRate: 1, Servers: 1, Capacity: capacity}.Solve()
+
+		// p_K = (1 - ρ) ρ^K / (1 - ρ^(K+1))
+		pRejected := (1 - rho) * math.Pow(rho, capacity) / (1 - math.Pow(rho, capacity+1))
+		Expect(metrics.PRejected).To(BeNumerically("~", pRejected, 1e-12))
+		Expect(metrics.Utilization).To(BeNumerically("~", rho*(1-pRejected), 1e-12))
+		// p = (8, 4, 2, 1) / 15, Lq = (2 + 2) / 15, λ_eff = 0.5 * 14 / 15
+		Expect(metrics.MeanWait.Seconds()).To(BeNumerically("~", (4.0/15)/(0.5*14/15), 1e-6))
+		Expect(metrics.TimeInSystem - metrics.MeanWait).To(Equal(time.Second))
+	})
+
+	It("Uses only slots which fit into the buffer", func() {
+		metrics := domain.MarkovModel{ArrivalRate: 1, ServiceRate: 1, Servers: 5, Capacity: 1}.Solve()
+		Expect(metrics.PRejected).To(BeNumerically("~", 0.5, 1e-12))
+		Expect(metrics.MeanWait).To(BeZero())
+	})
+
+	It("Compares with report", func() {
+		rows := domain.CompareWithModel(domain.ModelMetrics{PRejected: 0.1, Utilization: 0.5}, domain.Report{
+			Priorities: []domain.PriorityReport{
+				{Processed: 8, Rejected: 1},
+				{Processed: 0, Rejected: 1},
+			},
+			Processors: []domain.ProcessorReport{{Capacity: 2, Uptime: 10 * time.Second, InWork: 12 * time.Second}},
+		})
+		Expect(rows[0].Measured).To(BeNumerically("~", 0.2))
+		Expect(rows[0].RelativeError()).To(BeNumerically("~", 1))
+		Expect(rows[3].Measured).To(BeNumerically("~", 0.6))
+		Expect(rows[3].RelativeError()).To(BeNumerically("~", 0.2))
+	})
+})
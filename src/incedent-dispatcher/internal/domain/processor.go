@@ -6,8 +6,11 @@ import (
 )
 
 type IncedentProcessor struct {
-	Id   uint64
-	Host string
+	Id     uint64
+	Host   string
+	Weight uint32
+	// number of incedents processed in parallel, 0 means 1
+	Capacity uint32
 }
 
 func (i IncedentProcessor) String() string {
@@ -26,3 +29,11 @@ type ProcessorClientInfo struct {
 func (i ProcessorClientInfo) String() string {
 	return i.Processor.String()
 }
+
+// ProcessorStatus is what dispatcher knows about registered processor right now
+type ProcessorStatus struct {
+	Processor IncedentProcessor
+	// Busy is number of slots sending incedents
+	Busy     int
+	Draining bool
+}
@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"time"
+)
+
+// TimeStats is sample mean and variance of time intervals
+type TimeStats struct {
+	Mean time.Duration
+	// Variance in seconds squared
+	Variance float64
+}
+
+// NewTimeStats computes unbiased sample statistics, empty sample gives zeroes
+func NewTimeStats(sample []time.Duration) TimeStats {
+	if len(sample) == 0 {
+		return TimeStats{}
+	}
+
+	var sum float64
+	for _, d := range sample {
+		sum += d.Seconds()
+	}
+	mean := sum / float64(len(sample))
+	if len(sample) == 1 {
+		return TimeStats{Mean: secondsToDuration(mean)}
+	}
+
+	var squares float64
+	for _, d := range sample {
+		squares += (d.Seconds() - mean) * (d.Seconds() - mean)
+	}
+
+	return TimeStats{
+		Mean:     secondsToDuration(mean),
+		Variance: squares / float64(len(sample)-1),
+	}
+}
+
+type PriorityReport struct {
+	Priority   Priority
+	Total      int
+	Processed  int
+	Rejected   int
+	Evicted    int
+	Retried    int
+	InProgress int
+	// PRejected is probability of rejection among finished incedents
+	PRejected        float64
+	TimeInSystem     time.Duration
+	TimeInBuffer     TimeStats
+	TimeInProcessing TimeStats
+	// intervals are computed with Report.Precision confidence
+	PRejectedInterval ConfidenceInterval
+	// TimeInSystemInterval is in seconds, batch means of incedents in processing order
+	TimeInSystemInterval ConfidenceInterval
+	// RequiredIncedents is number of finished incedents to estimate PRejected with Report.Precision accuracy
+	RequiredIncedents int
+}
+
+// AccuracyReached reports whether intervals are narrow enough, probability of
+// priority without rejections can't be estimated and is skipped
+func (pr PriorityReport) AccuracyReached(accuracy float64) bool {
+	if pr.TimeInSystemInterval.Samples < ConfidenceBatches ||
+		pr.TimeInSystemInterval.RelativeHalfWidth() > accuracy {
+		return false
+	}
+
+	return pr.Rejected == 0 || pr.PRejectedInterval.RelativeHalfWidth() <= accuracy
+}
+
+type ProcessorReport struct {
+	Id             uint64
+	Capacity       int
+	RegTime        time.Time
+	EndTime        time.Time
+	Deregistered   bool
+	LeaseExpired   bool
+	Uptime         time.Duration
+	InWork         time.Duration
+	FailedAttempts int
+	// Utilization is share of slots time spent on incedents
+	Utilization float64
+}
+
+// Precision of statistics, Accuracy is relative half-width of confidence interval
+type Precision struct {
+	Confidence float64
+	Accuracy   float64
+}
+
+// WarmUp is start-up period excluded from statistics, it ends after Duration
+// or when Incedents are received, zero values disable it
+type WarmUp struct {
+	Duration  time.Duration
+	Incedents int
+}
+
+// Report is a snapshot of the dispatcher statistics
+type Report struct {
+	Time time.Time
+	// Since is start of statistics, it moves on warm-up end and reset
+	Since      time.Time
+	Precision  Precision
+	Priorities []PriorityReport
+	Processors []ProcessorReport
+	// ConfigChanges are all runtime changes in order, they aren't cleared on reset
+	ConfigChanges []ConfigChange
+}
+
+// AccuracyReached reports whether every priority is estimated with the report accuracy
+func (r Report) AccuracyReached() bool {
+	if len(r.Priorities) == 0 || r.Precision.Accuracy <= 0 {
+		return false
+	}
+	for _, stats := range r.Priorities {
+		if !stats.AccuracyReached(r.Precision.Accuracy) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ReportSummary is the report summed up over all priorities and processors
+type ReportSummary struct {
+	Total        int
+	PRejected    float64
+	MeanWait     time.Duration
+	TimeInSystem time.Duration
+	Utilization  float64
+}
+
+// Summary weights times by number of processed incedents and utilization by slots uptime
+func (r Report) Summary() ReportSummary {
+	var (
+		total, processed, rejected int
+		waitSum, inSystemSum       float64
+		inWork, slotsUptime        float64
+	)
+	for _, stats := range r.Priorities {
+		total += stats.Total
+		processed += stats.Processed
+		rejected += stats.Rejected
+		waitSum += stats.TimeInBuffer.Mean.Seconds() * float64(stats.Processed)
+		inSystemSum += stats.TimeInSystem.Seconds() * float64(stats.Processed)
+	}
+	for _, stats := range r.Processors {
+		inWork += stats.InWork.Seconds()
+		slotsUptime += stats.Uptime.Seconds() * float64(stats.Capacity)
+	}
+
+	return ReportSummary{
+		Total:        total,
+		PRejected:    ratio(float64(rejected), float64(processed+rejected)),
+		MeanWait:     secondsToDuration(ratio(waitSum, float64(processed))),
+		TimeInSystem: secondsToDuration(ratio(inSystemSum, float64(processed))),
+		Utilization:  ratio(inWork, slotsUptime),
+	}
+}
+
+func ratio(a, b float64) float64 {
+	if b == 0 {
+		return 0
+	}
+
+	return a / b
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"time"
+)
+
+// RetryPolicy describes how incedents are resent after transport failures
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Backoff returns delay before the next attempt, it doubles after each failed one
+func (rp RetryPolicy) Backoff(failedAttempts int) time.Duration {
+	backoff := rp.InitialBackoff
+	for i := 1; i < failedAttempts && backoff < rp.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, rp.MaxBackoff)
+}
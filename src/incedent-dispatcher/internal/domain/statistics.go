@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"math"
+)
+
+// ConfidenceBatches is number of batches of batch means method
+const ConfidenceBatches = 20
+
+// ConfidenceInterval is Mean ± HalfWidth, interval of less than two samples is unknown
+type ConfidenceInterval struct {
+	Mean      float64
+	HalfWidth float64
+	Samples   int
+}
+
+// RelativeHalfWidth is infinite for unknown interval or zero mean
+func (ci ConfidenceInterval) RelativeHalfWidth() float64 {
+	if ci.Samples < 2 || ci.Mean == 0 {
+		return math.Inf(1)
+	}
+
+	return ci.HalfWidth / math.Abs(ci.Mean)
+}
+
+// NewProportionInterval is normal approximation of binomial proportion of successes out of n
+func NewProportionInterval(successes, n int, confidence float64) ConfidenceInterval {
+	if n == 0 {
+		return ConfidenceInterval{}
+	}
+	p := float64(successes) / float64(n)
+
+	return ConfidenceInterval{
+		Mean:      p,
+		HalfWidth: NormalQuantile(confidence) * math.Sqrt(p*(1-p)/float64(n)),
+		Samples:   n,
+	}
+}
+
+// NewBatchMeansInterval splits ordered sample into batches, so means of batches
+// are nearly independent even if neighbour values are correlated
+func NewBatchMeansInterval(sample []float64, confidence float64) ConfidenceInterval {
+	batches := min(len(sample), ConfidenceBatches)
+	if batches < 2 {
+		return ConfidenceInterval{Samples: len(sample)}
+	}
+
+	means := make([]float64, batches)
+	var total float64
+	for i := range batches {
+		batch := sample[i*len(sample)/batches : (i+1)*len(sample)/batches]
+		var sum float64
+		for _, value := range batch {
+			sum += value
+		}
+		means[i] = sum / float64(len(batch))
+		total += sum
+	}
+	mean := total / float64(len(sample))
+
+	var squares float64
+	for _, batchMean := range means {
+		squares += (batchMean - mean) * (batchMean - mean)
+	}
+	stdErr := math.Sqrt(squares / float64(batches-1) / float64(batches))
+
+	return ConfidenceInterval{
+		Mean:      mean,
+		HalfWidth: StudentQuantile(confidence, batches-1) * stdErr,
+		Samples:   len(sample),
+	}
+}
+
+// RequiredIncedents is the classic N = t²(1-p)/(p·δ²), number of incedents
+// to estimate probability p with relative accuracy δ, zero p gives zero
+func RequiredIncedents(p, confidence, accuracy float64) int {
+	if p <= 0 || accuracy <= 0 {
+		return 0
+	}
+	t := NormalQuantile(confidence)
+
+	return int(math.Ceil(t * t * (1 - p) / (p * accuracy * accuracy)))
+}
+
+// NormalQuantile returns t such that standard normal value falls into [-t, t] with given probability
+func NormalQuantile(confidence float64) float64 {
+	return math.Sqrt2 * math.Erfinv(confidence)
+}
+
+// StudentQuantile is two-sided quantile of Student distribution,
+// Cornish-Fisher expansion is accurate to 1e-3 for more than 3 degrees of freedom
+func StudentQuantile(confidence float64, degrees int) float64 {
+	z := NormalQuantile(confidence)
+	v := float64(degrees)
+	z3, z5, z7 := math.Pow(z, 3), math.Pow(z, 5), math.Pow(z, 7)
+
+	return z +
+		(z3+z)/(4*v) +
+		(5*z5+16*z3+3*z)/(96*v*v) +
+		(3*z7+19*z5+17*z3-15*z)/(384*v*v*v)
+}
@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+)
+
+var _ = Describe("Statistics", func() {
+	It("Computes quantiles", func() {
+		Expect(domain.NormalQuantile(0.95)).To(BeNumerically("~", 1.95996, 1e-5))
+		// tables: t(0.95, 19) = 2.093, t(0.95, 9) = 2.262
+		Expect(domain.StudentQuantile(0.95, 19)).To(BeNumerically("~", 2.093, 1e-3))
+		Expect(domain.StudentQuantile(0.95, 9)).To(BeNumerically("~", 2.262, 1e-3))
+	})
+
+	It("Computes required number of incedents", func() {
+		// N = 1.96² * 0.9 / (0.1 * 0.1²)
+		Expect(domain.RequiredIncedents(0.1, 0.95, 0.1)).To(Equal(3458))
+		Expect(domain.RequiredIncedents(0, 0.95, 0.1)).To(BeZero())
+	})
+
+	It("Computes proportion interval", func() {
+		interval := domain.NewProportionInterval(10, 100, 0.95)
+		Expect(interval.Mean).To(Equal(0.1))
+		Expect(interval.HalfWidth).To(BeNumerically("~", 1.96*0.03, 1e-4))
+		Expect(interval.RelativeHalfWidth()).To(BeNumerically("~", 0.588, 1e-3))
+	})
+
+	It("Computes batch means interval", func() {
+		// batches alternate between 1 and 3
+		sample := make([]float64, 0, 2*domain.ConfidenceBatches)
+		for i := range domain.ConfidenceBatches {
+			value := float64(1 + 2*(i%2))
+			sample = append(sample, value, value)
+		}
+
+		interval := domain.NewBatchMeansInterval(sample, 0.95)
+		Expect(interval.Mean).To(Equal(2.0))
+		Expect(interval.Samples).To(Equal(len(sample)))
+		stdErr := math.Sqrt(float64(domain.ConfidenceBatches) / (domain.ConfidenceBatches - 1) / domain.ConfidenceBatches)
+		Expect(interval.HalfWidth).To(BeNumerically("~", domain.StudentQuantile(0.95, 19)*stdErr, 1e-12))
+	})
+
+	It("Leaves interval of one sample unknown", func() {
+		interval := domain.NewBatchMeansInterval([]float64{1}, 0.95)
+		Expect(math.IsInf(interval.RelativeHalfWidth(), 1)).To(BeTrue())
+	})
+
+	It("Checks accuracy of report", func() {
+		stats := domain.PriorityReport{
+			Rejected:             10,
+			PRejectedInterval:    domain.ConfidenceInterval{Mean: 0.1, HalfWidth: 0.005, Samples: 1000},
+			TimeInSystemInterval: domain.ConfidenceInterval{Mean: 2, HalfWidth: 0.1, Samples: 1000},
+		}
+		report := domain.Report{
+			Precision:  domain.Precision{Confidence: 0.95, Accuracy: 0.1},
+			Priorities: []domain.PriorityReport{stats},
+		}
+		Expect(report.AccuracyReached()).To(BeTrue())
+
+		stats.PRejectedInterval.HalfWidth = 0.02
+		report.Priorities = []domain.PriorityReport{stats}
+		Expect(report.AccuracyReached()).To(BeFalse())
+
+		// probability without rejections isn't estimated
+		stats.Rejected = 0
+		report.Priorities = []domain.PriorityReport{stats}
+		Expect(report.AccuracyReached()).To(BeTrue())
+
+		report.Precision.Accuracy = 0
+		Expect(report.AccuracyReached()).To(BeFalse())
+	})
+})
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// SweepPoint is one combination of swept parameters
+type SweepPoint struct {
+	Processors     int
+	BufferCapacity uint64
+	// ProducerInterval is mean time between incedents of every producer
+	ProducerInterval time.Duration
+}
+
+func (p SweepPoint) String() string {
+	return fmt.Sprintf("SweepPoint{%v, %v, %v}", p.Processors, p.BufferCapacity, p.ProducerInterval)
+}
+
+// SweepResult is summary of the point run
+type SweepResult struct {
+	Point   SweepPoint
+	Summary ReportSummary
+	// MeetsThreshold is true when rejection probability isn't above the threshold
+	MeetsThreshold bool
+}
+
+// StopRule decides how many incedents are simulated
+type StopRule struct {
+	// Incedents is the number of incedents of the first run
+	Incedents int
+	// Confidence enables reruns until rejection probability is known with Accuracy, 0 disables them
+	Confidence float64
+	// Accuracy is relative half-width of the confidence interval
+	Accuracy float64
+	// MaxIncedents bounds the number of incedents required by Confidence, 0 means no bound
+	MaxIncedents int
+}
+
+// Required returns number of incedents to estimate pRejected with the rule accuracy,
+// zero probability can't be estimated, so any run is long enough
+func (r StopRule) Required(pRejected float64) int {
+	if r.Confidence == 0 {
+		return r.Incedents
+	}
+	required := RequiredIncedents(pRejected, r.Confidence, r.Accuracy)
+	if r.MaxIncedents > 0 {
+		return min(required, r.MaxIncedents)
+	}
+
+	return required
+}
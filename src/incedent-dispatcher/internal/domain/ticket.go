@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+const ticketTagSize = 8
+
+// Ticket identifies incedent submitted asynchronously
+type Ticket string
+
+// TicketSigner makes tickets which can't be guessed from priority and id of incedent,
+// tickets are valid while the signer key is kept
+type TicketSigner struct {
+	key []byte
+}
+
+func NewTicketSigner(key []byte) TicketSigner {
+	return TicketSigner{key: key}
+}
+
+func (ts TicketSigner) NewTicket(incedent Incedent) Ticket {
+	return Ticket(fmt.Sprintf("%d-%d-%s", incedent.Priority, incedent.Id, ts.tag(incedent.Priority, incedent.Id)))
+}
+
+// ParseTicket returns priority and id of incedent, ticket which wasn't made by the signer is unknown
+func (ts TicketSigner) ParseTicket(ticket Ticket) (Priority, uint64, error) {
+	var (
+		priority Priority
+		id       uint64
+		tag      string
+	)
+	if _, err := fmt.Sscanf(string(ticket), "%d-%d-%s", &priority, &id, &tag); err != nil {
+		return 0, 0, fmt.Errorf("bad ticket '%s': %w", ticket, ErrBadTicket)
+	}
+	if !hmac.Equal([]byte(tag), []byte(ts.tag(priority, id))) {
+		return 0, 0, fmt.Errorf("no incedent for ticket '%s': %w", ticket, ErrUnknownIncedent)
+	}
+
+	return priority, id, nil
+}
+
+func (ts TicketSigner) tag(priority Priority, id uint64) string {
+	mac := hmac.New(sha256.New, ts.key)
+	fmt.Fprintf(mac, "%d-%d", priority, id)
+
+	return hex.EncodeToString(mac.Sum(nil)[:ticketTagSize])
+}
@@ -2,34 +2,78 @@ package repositories
 
 import (
 	"errors"
-	"fmt"
 	"slices"
 	"sync"
 
+	"go.uber.org/zap"
+
 	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
 	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
 )
 
 var (
 	errBufferFull      = errors.New("buffer is full")
-	errNothingToEvict  = errors.New("there are no incedents to evict")
+	errEarlyDropped    = errors.New("incedent dropped by eviction policy")
 	errElementNotFound = errors.New("element not found")
 )
 
+// BufferStorage keeps incedents in indexed heaps per priority,
+// so put, evict and delete take O(log n).
 type BufferStorage struct {
 	log         *logger.Logger
 	mu          sync.Mutex
 	maxCapacity int
 	currentSize int
-	buffer      map[domain.Priority][]domain.Incedent
+	queues      map[domain.Priority]*priorityQueue
+	index       map[incedentKey]*heapItem
+	policy      evictionPolicy
+	wal         *WriteAheadLog
+	journal     *Journal
+	// added is closed and replaced when incedent is put
+	added chan struct{}
 }
 
-func NewBufferStorage(log *logger.Logger, bufferCapacity uint64) *BufferStorage {
+// NewBufferStorage creates buffer, wal and journal are optional and can be nil
+func NewBufferStorage(
+	log *logger.Logger,
+	bufferCapacity uint64,
+	policy evictionPolicy,
+	wal *WriteAheadLog,
+	journal *Journal,
+) *BufferStorage {
 	return &BufferStorage{
 		log:         log,
 		maxCapacity: int(bufferCapacity),
-		buffer:      make(map[domain.Priority][]domain.Incedent),
+		queues:      make(map[domain.Priority]*priorityQueue),
+		index:       make(map[incedentKey]*heapItem, bufferCapacity),
+		policy:      policy,
+		wal:         wal,
+		journal:     journal,
+		added:       make(chan struct{}),
+	}
+}
+
+// Restore puts incedents replayed from the write-ahead log back into the buffer,
+// incedents which don't fit are dropped.
+func (bs *BufferStorage) Restore() []domain.Incedent {
+	if bs.wal == nil {
+		return nil
+	}
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	restored := make([]domain.Incedent, 0)
+	for _, incedent := range bs.wal.Incedents() {
+		if bs.currentSize >= bs.maxCapacity {
+			bs.log.Warn("Buffer is full, dropping restored incedent", zap.Stringer("incedent", incedent))
+			bs.walDelete(incedent)
+			continue
+		}
+		bs.putIncedent(incedent)
+		restored = append(restored, incedent)
 	}
+
+	return restored
 }
 
 func (bs *BufferStorage) CheckAndPut(incedent domain.Incedent) error {
@@ -38,7 +82,11 @@ func (bs *BufferStorage) CheckAndPut(incedent domain.Incedent) error {
 	if bs.currentSize >= bs.maxCapacity {
 		return errBufferFull
 	}
+	if !bs.policy.admit(bs, incedent) {
+		return errEarlyDropped
+	}
 	bs.putIncedent(incedent)
+	bs.walPut(incedent)
 
 	return nil
 }
@@ -47,39 +95,50 @@ func (bs *BufferStorage) EvictAndPut(incedent domain.Incedent) domain.Incedent {
 	bs.mu.Lock()
 	defer bs.mu.Unlock()
 
-	for priority := range bs.buffer {
-		if priority < incedent.Priority {
-			evicted, err := bs.evictOldest(priority)
-			if err != nil {
-				// try all lower priorities
-				continue
-			}
-			bs.putIncedent(incedent)
-
-			return evicted
-		}
+	evicted := bs.policy.victim(bs, incedent)
+	if keyOf(evicted) == keyOf(incedent) {
+		bs.journal.Record(domain.EventEvicted, incedent, 0)
+		return incedent
 	}
-	evicted, err := bs.evictOldest(incedent.Priority)
-	if err != nil {
+	if err := bs.deleteIncedent(evicted); err != nil {
+		bs.log.Error("Eviction policy chose incedent out of buffer", zap.Stringer("incedent", evicted))
+		bs.journal.Record(domain.EventEvicted, incedent, 0)
 		return incedent
 	}
+	bs.journal.Record(domain.EventEvicted, evicted, 0)
+	bs.walDelete(evicted)
 	bs.putIncedent(incedent)
+	bs.walPut(incedent)
 
 	return evicted
 }
 
+// Added returns channel which is closed when incedent is put,
+// it must be taken before GetPacket so that no put is missed
+func (bs *BufferStorage) Added() <-chan struct{} {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	return bs.added
+}
+
+// GetPacket ejects all incedents of the highest priority, they still occupy
+// the buffer until DeleteIncedent is called.
 func (bs *BufferStorage) GetPacket() []domain.Incedent {
 	bs.mu.Lock()
 	defer bs.mu.Unlock()
 
-	var maxPriority domain.Priority
-	for priority := range bs.buffer {
-		if priority > maxPriority {
-			maxPriority = priority
-		}
+	if len(bs.queues) == 0 {
+		return nil
+	}
+	maxPriority := slices.Max(bs.priorities())
+	packet := bs.queues[maxPriority].drain()
+	delete(bs.queues, maxPriority)
+	for _, incedent := range packet {
+		delete(bs.index, keyOf(incedent))
 	}
 
-	return bs.ejectPacket(maxPriority)
+	return packet
 }
 
 func (bs *BufferStorage) DeleteIncedent(incedent domain.Incedent) error {
@@ -88,74 +147,172 @@ func (bs *BufferStorage) DeleteIncedent(incedent domain.Incedent) error {
 
 	err := bs.deleteIncedent(incedent)
 	if err != nil {
+		// incedent was ejected with packet
 		bs.currentSize--
 	}
+	bs.walDelete(incedent)
 
 	return nil
 }
 
-func (bs *BufferStorage) evictOldest(priority domain.Priority) (domain.Incedent, error) {
-	packet := bs.getPacket(priority)
-	if len(packet) == 0 {
-		return domain.Incedent{}, errNothingToEvict
+// SetCapacity resizes the buffer, if it shrinks incedents are evicted by the current policy
+// as if the newest buffered one arrived to the full buffer. Incedents ejected with packet
+// can't be evicted, buffer stays overfilled until they are deleted.
+func (bs *BufferStorage) SetCapacity(capacity uint64) []domain.Incedent {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	bs.maxCapacity = int(capacity)
+	evicted := make([]domain.Incedent, 0)
+	for bs.currentSize > bs.maxCapacity {
+		incoming, ok := bs.newestIncedent()
+		if !ok {
+			break
+		}
+		victim := bs.policy.victim(bs, incoming)
+		if err := bs.deleteIncedent(victim); err != nil {
+			bs.log.Error("Eviction policy chose incedent out of buffer", zap.Stringer("incedent", victim))
+			victim = incoming
+			bs.deleteIncedent(victim)
+		}
+		bs.journal.Record(domain.EventEvicted, victim, 0)
+		bs.walDelete(victim)
+		evicted = append(evicted, victim)
 	}
-	// sort in descending order by creation time
-	slices.SortFunc(packet, func(a, b domain.Incedent) int {
-		return a.CreationTime.Compare(b.CreationTime) * -1
-	})
-	incedent := packet[len(packet)-1]
-	packet = packet[:len(packet)-1]
-	bs.buffer[priority] = packet
-	bs.currentSize--
 
-	return incedent, nil
+	return evicted
 }
 
-func (bs *BufferStorage) putIncedent(incedent domain.Incedent) {
-	// check slice exists
-	_ = bs.getPacket(incedent.Priority)
+// State returns buffered incedents from the highest priority and the oldest one,
+// ejected incedents are only counted in size
+func (bs *BufferStorage) State() domain.BufferState {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
 
-	bs.buffer[incedent.Priority] = append(bs.buffer[incedent.Priority], incedent)
-	bs.currentSize++
+	state := domain.BufferState{
+		Capacity:  bs.maxCapacity,
+		Size:      bs.currentSize,
+		Incedents: make([]domain.Incedent, 0, len(bs.index)),
+	}
+	priorities := bs.priorities()
+	slices.Reverse(priorities)
+	for _, priority := range priorities {
+		state.Incedents = append(state.Incedents, bs.queues[priority].incedents()...)
+	}
+
+	return state
 }
 
-func (bs *BufferStorage) getPacket(priority domain.Priority) []domain.Incedent {
-	packet, ok := bs.buffer[priority]
+// SetEvictionPolicy replaces policy, buffered incedents are kept
+func (bs *BufferStorage) SetEvictionPolicy(name string) error {
+	policy, err := NewEvictionPolicy(name)
+	if err != nil {
+		return err
+	}
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	bs.policy = policy
+
+	return nil
+}
+
+func (bs *BufferStorage) size() int {
+	return bs.currentSize
+}
+
+func (bs *BufferStorage) capacity() int {
+	return bs.maxCapacity
+}
+
+func (bs *BufferStorage) priorities() []domain.Priority {
+	priorities := make([]domain.Priority, 0, len(bs.queues))
+	for priority := range bs.queues {
+		priorities = append(priorities, priority)
+	}
+	slices.Sort(priorities)
+
+	return priorities
+}
+
+func (bs *BufferStorage) oldest(priority domain.Priority) (domain.Incedent, bool) {
+	queue, ok := bs.queues[priority]
 	if !ok {
-		packet = make([]domain.Incedent, 0, bs.maxCapacity)
-		bs.buffer[priority] = packet
+		return domain.Incedent{}, false
 	}
 
-	return packet
+	return queue.peek(oldestFirst)
 }
 
-func (bs *BufferStorage) ejectPacket(priority domain.Priority) []domain.Incedent {
-	oldPacket := bs.getPacket(priority)
-	bs.buffer[priority] = make([]domain.Incedent, 0, bs.maxCapacity)
+func (bs *BufferStorage) newest(priority domain.Priority) (domain.Incedent, bool) {
+	queue, ok := bs.queues[priority]
+	if !ok {
+		return domain.Incedent{}, false
+	}
 
-	return oldPacket
+	return queue.peek(newestFirst)
 }
 
-func (bs *BufferStorage) deleteIncedent(incedent domain.Incedent) error {
-	packet := bs.getPacket(incedent.Priority)
-	for i, curr := range packet {
-		if curr.Id == incedent.Id {
-			packet = sliceDelete(packet, i)
-			bs.buffer[incedent.Priority] = packet
-			bs.currentSize--
-
-			return nil
+func (bs *BufferStorage) newestIncedent() (domain.Incedent, bool) {
+	newest, found := domain.Incedent{}, false
+	for _, priority := range bs.priorities() {
+		curr, ok := bs.newest(priority)
+		if ok && (!found || curr.CreationTime.After(newest.CreationTime)) {
+			newest, found = curr, true
 		}
 	}
 
-	return errElementNotFound
+	return newest, found
 }
 
-func sliceDelete[T any](slice []T, index int) []T {
-	if index >= len(slice) {
-		panic(fmt.Sprintf("cant delete from slice with len (%d) requested index (%d)", len(slice), index))
+func (bs *BufferStorage) putIncedent(incedent domain.Incedent) {
+	queue, ok := bs.queues[incedent.Priority]
+	if !ok {
+		queue = newPriorityQueue()
+		bs.queues[incedent.Priority] = queue
 	}
-	slice[index] = slice[len(slice)-1]
 
-	return slice[:len(slice)-1]
+	item := &heapItem{incedent: incedent}
+	queue.push(item)
+	bs.index[keyOf(incedent)] = item
+	bs.currentSize++
+	bs.journal.Record(domain.EventBuffered, incedent, 0)
+	close(bs.added)
+	bs.added = make(chan struct{})
+}
+
+func (bs *BufferStorage) deleteIncedent(incedent domain.Incedent) error {
+	key := keyOf(incedent)
+	item, ok := bs.index[key]
+	if !ok {
+		return errElementNotFound
+	}
+
+	queue := bs.queues[incedent.Priority]
+	queue.remove(item)
+	if queue.len() == 0 {
+		delete(bs.queues, incedent.Priority)
+	}
+	delete(bs.index, key)
+	bs.currentSize--
+
+	return nil
+}
+
+func (bs *BufferStorage) walPut(incedent domain.Incedent) {
+	if bs.wal == nil {
+		return
+	}
+	if err := bs.wal.Put(incedent); err != nil {
+		bs.log.Error("Failed to log incedent", zap.Stringer("incedent", incedent), zap.Error(err))
+	}
+}
+
+func (bs *BufferStorage) walDelete(incedent domain.Incedent) {
+	if bs.wal == nil {
+		return
+	}
+	if err := bs.wal.Delete(incedent); err != nil {
+		bs.log.Error("Failed to log incedent deletion", zap.Stringer("incedent", incedent), zap.Error(err))
+	}
 }
@@ -0,0 +1,230 @@
+package repositories
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+const benchPriorities = 4
+
+var benchSizes = []int{1_000, 10_000, 50_000}
+
+type benchedBuffer interface {
+	CheckAndPut(incedent domain.Incedent) error
+	DeleteIncedent(incedent domain.Incedent) error
+	EvictAndPut(incedent domain.Incedent) domain.Incedent
+	GetPacket() []domain.Incedent
+}
+
+type bufferFactory func(capacity int) benchedBuffer
+
+var benchedBuffers = []struct {
+	name string
+	new  bufferFactory
+}{
+	{"slice", func(capacity int) benchedBuffer {
+		return newSliceBufferStorage(logger.InitZapWrapper(zap.NewNop()), uint64(capacity), lowerPriorityPolicy{})
+	}},
+	{"heap", func(capacity int) benchedBuffer {
+		return NewBufferStorage(logger.InitZapWrapper(zap.NewNop()), uint64(capacity), lowerPriorityPolicy{}, nil, nil)
+	}},
+}
+
+var _ = Describe("BufferStorage", func() {
+	var storage *BufferStorage
+
+	BeforeEach(func() {
+		policy, err := NewEvictionPolicy(DropOldestPolicy)
+		Expect(err).To(Succeed())
+		storage = NewBufferStorage(logger.InitZapWrapper(zap.NewNop()), 4, policy, nil, nil)
+	})
+
+	It("GetPacket returns the highest priority from the oldest", func() {
+		incedents := []domain.Incedent{
+			newTestIncedent(1, 2, 2*time.Second),
+			newTestIncedent(2, 1, 0),
+			newTestIncedent(3, 2, time.Second),
+		}
+		for _, incedent := range incedents {
+			Expect(storage.CheckAndPut(incedent)).To(Succeed())
+		}
+
+		Expect(storage.GetPacket()).To(Equal([]domain.Incedent{incedents[2], incedents[0]}))
+		Expect(storage.GetPacket()).To(Equal([]domain.Incedent{incedents[1]}))
+		Expect(storage.GetPacket()).To(BeEmpty())
+	})
+
+	It("Ejected incedents occupy buffer until deleted", func() {
+		incedents := []domain.Incedent{
+			newTestIncedent(1, 1, 0),
+			newTestIncedent(2, 1, time.Second),
+			newTestIncedent(3, 1, 2*time.Second),
+			newTestIncedent(4, 1, 3*time.Second),
+		}
+		for _, incedent := range incedents {
+			Expect(storage.CheckAndPut(incedent)).To(Succeed())
+		}
+		packet := storage.GetPacket()
+		Expect(packet).To(HaveLen(len(incedents)))
+
+		incoming := newTestIncedent(5, 1, 4*time.Second)
+		Expect(storage.CheckAndPut(incoming)).NotTo(Succeed())
+		Expect(storage.EvictAndPut(incoming)).To(Equal(incoming))
+
+		Expect(storage.DeleteIncedent(packet[0])).To(Succeed())
+		Expect(storage.CheckAndPut(incoming)).To(Succeed())
+		Expect(storage.size()).To(Equal(len(incedents)))
+	})
+
+	It("DeleteIncedent removes buffered incedent", func() {
+		incedents := []domain.Incedent{
+			newTestIncedent(1, 1, 0),
+			newTestIncedent(2, 1, time.Second),
+			newTestIncedent(3, 1, 2*time.Second),
+		}
+		for _, incedent := range incedents {
+			Expect(storage.CheckAndPut(incedent)).To(Succeed())
+		}
+
+		Expect(storage.DeleteIncedent(incedents[1])).To(Succeed())
+		oldest, ok := storage.oldest(1)
+		Expect(ok).To(BeTrue())
+		Expect(oldest).To(Equal(incedents[0]))
+		newest, ok := storage.newest(1)
+		Expect(ok).To(BeTrue())
+		Expect(newest).To(Equal(incedents[2]))
+		Expect(storage.GetPacket()).To(Equal([]domain.Incedent{incedents[0], incedents[2]}))
+	})
+
+	It("Shrinking evicts by the current policy", func() {
+		incedents := []domain.Incedent{
+			newTestIncedent(1, 2, 0),
+			newTestIncedent(2, 1, time.Second),
+			newTestIncedent(3, 2, 2*time.Second),
+			newTestIncedent(4, 1, 3*time.Second),
+		}
+		for _, incedent := range incedents {
+			Expect(storage.CheckAndPut(incedent)).To(Succeed())
+		}
+
+		Expect(storage.SetCapacity(3)).To(Equal([]domain.Incedent{incedents[0]}))
+		Expect(storage.SetEvictionPolicy(LowestPriorityPolicy)).To(Succeed())
+		Expect(storage.SetCapacity(1)).To(Equal([]domain.Incedent{incedents[1], incedents[3]}))
+		Expect(storage.GetPacket()).To(Equal([]domain.Incedent{incedents[2]}))
+
+		Expect(storage.SetEvictionPolicy("unknown")).NotTo(Succeed())
+		Expect(storage.SetCapacity(2)).To(BeEmpty())
+		Expect(storage.CheckAndPut(newTestIncedent(5, 1, 4*time.Second))).To(Succeed())
+	})
+
+	It("State lists incedents from the highest priority", func() {
+		incedents := []domain.Incedent{
+			newTestIncedent(1, 1, 0),
+			newTestIncedent(2, 2, time.Second),
+			newTestIncedent(3, 1, 2*time.Second),
+		}
+		for _, incedent := range incedents {
+			Expect(storage.CheckAndPut(incedent)).To(Succeed())
+		}
+		Expect(storage.GetPacket()).To(HaveLen(1))
+
+		Expect(storage.State()).To(Equal(domain.BufferState{
+			Capacity:  4,
+			Size:      3,
+			Incedents: []domain.Incedent{incedents[0], incedents[2]},
+		}))
+	})
+
+	It("Shrinking keeps ejected incedents", func() {
+		incedent := newTestIncedent(1, 1, 0)
+		Expect(storage.CheckAndPut(incedent)).To(Succeed())
+		Expect(storage.GetPacket()).To(HaveLen(1))
+
+		Expect(storage.SetCapacity(1)).To(BeEmpty())
+		Expect(storage.size()).To(Equal(1))
+	})
+})
+
+func benchIncedent(i int) domain.Incedent {
+	return domain.Incedent{
+		Id:           uint64(i),
+		Priority:     domain.Priority(i % benchPriorities),
+		CreationTime: testStartTime.Add(time.Duration(i) * time.Millisecond),
+	}
+}
+
+func fillBuffer(newBuffer bufferFactory, size int) benchedBuffer {
+	buffer := newBuffer(size)
+	for i := 0; i < size; i++ {
+		if err := buffer.CheckAndPut(benchIncedent(i)); err != nil {
+			panic(err)
+		}
+	}
+
+	return buffer
+}
+
+func runBufferBenchmark(b *testing.B, bench func(b *testing.B, newBuffer bufferFactory, size int)) {
+	for _, buffer := range benchedBuffers {
+		for _, size := range benchSizes {
+			b.Run(fmt.Sprintf("%s/%d", buffer.name, size), func(b *testing.B) {
+				bench(b, buffer.new, size)
+			})
+		}
+	}
+}
+
+func BenchmarkBufferCheckAndPut(b *testing.B) {
+	runBufferBenchmark(b, func(b *testing.B, newBuffer bufferFactory, size int) {
+		buffer := fillBuffer(newBuffer, size-1)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			incedent := benchIncedent(size + i)
+			_ = buffer.CheckAndPut(incedent)
+			_ = buffer.DeleteIncedent(incedent)
+		}
+	})
+}
+
+func BenchmarkBufferEvictAndPut(b *testing.B) {
+	runBufferBenchmark(b, func(b *testing.B, newBuffer bufferFactory, size int) {
+		buffer := fillBuffer(newBuffer, size)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			incedent := benchIncedent(size + i)
+			incedent.Priority = benchPriorities
+			buffer.EvictAndPut(incedent)
+		}
+	})
+}
+
+func BenchmarkBufferDeleteIncedent(b *testing.B) {
+	runBufferBenchmark(b, func(b *testing.B, newBuffer bufferFactory, size int) {
+		buffer := fillBuffer(newBuffer, size)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			incedent := benchIncedent(i % size)
+			_ = buffer.DeleteIncedent(incedent)
+			_ = buffer.CheckAndPut(incedent)
+		}
+	})
+}
+
+func BenchmarkBufferGetPacket(b *testing.B) {
+	runBufferBenchmark(b, func(b *testing.B, newBuffer bufferFactory, size int) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			buffer := fillBuffer(newBuffer, size)
+			b.StartTimer()
+			buffer.GetPacket()
+		}
+	})
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+)
+
+// WriteComparison prints theoretical and measured metrics side by side
+func WriteComparison(w io.Writer, rows []domain.ComparisonRow) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "metric\ttheoretical\tmeasured\trelative error")
+	for _, row := range rows {
+		fmt.Fprintf(tw, "%s\t%.6g\t%.6g\t%.2f%%\n", row.Metric, row.Theoretical, row.Measured, row.RelativeError()*100)
+	}
+
+	return tw.Flush()
+}
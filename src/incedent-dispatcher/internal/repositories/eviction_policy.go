@@ -0,0 +1,176 @@
+package repositories
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+)
+
+const (
+	LowerPriorityPolicy   = "lower-priority"
+	LowestPriorityPolicy  = "lowest-priority"
+	DropOldestPolicy      = "drop-oldest"
+	DropNewestPolicy      = "drop-newest"
+	RejectPolicy          = "reject"
+	RandomEarlyDropPolicy = "random-early-drop"
+)
+
+const (
+	// buffer occupancy after which random early drop starts to refuse incedents
+	redMinThreshold = 0.5
+	// probability to refuse incedent when buffer is almost full
+	redMaxProbability = 0.2
+)
+
+// bufferView is a read-only view of the buffer given to eviction policies,
+// it's called under the buffer lock.
+type bufferView interface {
+	size() int
+	capacity() int
+	// priorities returns non-empty priorities in ascending order
+	priorities() []domain.Priority
+	oldest(priority domain.Priority) (domain.Incedent, bool)
+	newest(priority domain.Priority) (domain.Incedent, bool)
+}
+
+// evictionPolicy decides which incedent leaves the buffer when new one arrives.
+type evictionPolicy interface {
+	// admit reports whether incedent can be put into the buffer which still has free space
+	admit(view bufferView, incoming domain.Incedent) bool
+	// victim returns incedent to evict in favour of incoming one,
+	// returning incoming itself means that it is rejected
+	victim(view bufferView, incoming domain.Incedent) domain.Incedent
+}
+
+func NewEvictionPolicy(name string) (evictionPolicy, error) {
+	switch name {
+	case "", LowerPriorityPolicy:
+		return lowerPriorityPolicy{}, nil
+	case LowestPriorityPolicy:
+		return lowestPriorityPolicy{}, nil
+	case DropOldestPolicy:
+		return dropOldestPolicy{}, nil
+	case DropNewestPolicy:
+		return dropNewestPolicy{}, nil
+	case RejectPolicy:
+		return rejectPolicy{}, nil
+	case RandomEarlyDropPolicy:
+		return randomEarlyDropPolicy{}, nil
+	default:
+		return nil, fmt.Errorf("unknown eviction policy '%s'", name)
+	}
+}
+
+type alwaysAdmit struct{}
+
+func (alwaysAdmit) admit(bufferView, domain.Incedent) bool {
+	return true
+}
+
+// lowerPriorityPolicy evicts the oldest incedent of any lower priority,
+// falling back to the oldest one of the same priority.
+type lowerPriorityPolicy struct {
+	alwaysAdmit
+}
+
+func (lowerPriorityPolicy) victim(view bufferView, incoming domain.Incedent) domain.Incedent {
+	lower := make([]domain.Priority, 0)
+	for _, priority := range view.priorities() {
+		if priority < incoming.Priority {
+			lower = append(lower, priority)
+		}
+	}
+	rand.Shuffle(len(lower), func(i, j int) {
+		lower[i], lower[j] = lower[j], lower[i]
+	})
+	for _, priority := range append(lower, incoming.Priority) {
+		if evicted, ok := view.oldest(priority); ok {
+			return evicted
+		}
+	}
+
+	return incoming
+}
+
+// lowestPriorityPolicy evicts the oldest incedent of the lowest priority
+// if it isn't higher than the incoming one.
+type lowestPriorityPolicy struct {
+	alwaysAdmit
+}
+
+func (lowestPriorityPolicy) victim(view bufferView, incoming domain.Incedent) domain.Incedent {
+	priorities := view.priorities()
+	if len(priorities) == 0 || priorities[0] > incoming.Priority {
+		return incoming
+	}
+	if evicted, ok := view.oldest(priorities[0]); ok {
+		return evicted
+	}
+
+	return incoming
+}
+
+// dropOldestPolicy evicts the oldest incedent regardless of its priority.
+type dropOldestPolicy struct {
+	alwaysAdmit
+}
+
+func (dropOldestPolicy) victim(view bufferView, incoming domain.Incedent) domain.Incedent {
+	evicted, found := incoming, false
+	for _, priority := range view.priorities() {
+		curr, ok := view.oldest(priority)
+		if ok && (!found || curr.CreationTime.Before(evicted.CreationTime)) {
+			evicted, found = curr, true
+		}
+	}
+
+	return evicted
+}
+
+// dropNewestPolicy evicts the most recent incedent regardless of its priority.
+type dropNewestPolicy struct {
+	alwaysAdmit
+}
+
+func (dropNewestPolicy) victim(view bufferView, incoming domain.Incedent) domain.Incedent {
+	evicted, found := incoming, false
+	for _, priority := range view.priorities() {
+		curr, ok := view.newest(priority)
+		if ok && (!found || curr.CreationTime.After(evicted.CreationTime)) {
+			evicted, found = curr, true
+		}
+	}
+
+	return evicted
+}
+
+// rejectPolicy never evicts buffered incedents, incoming one is rejected.
+type rejectPolicy struct {
+	alwaysAdmit
+}
+
+func (rejectPolicy) victim(_ bufferView, incoming domain.Incedent) domain.Incedent {
+	return incoming
+}
+
+// randomEarlyDropPolicy refuses incoming incedents with probability growing
+// linearly with buffer occupancy, full buffer rejects everything.
+type randomEarlyDropPolicy struct{}
+
+func (randomEarlyDropPolicy) admit(view bufferView, _ domain.Incedent) bool {
+	if view.capacity() == 0 {
+		return false
+	}
+	occupancy := float64(view.size()) / float64(view.capacity())
+	if occupancy < redMinThreshold {
+		return true
+	}
+	pDrop := redMaxProbability * (occupancy - redMinThreshold) / (1 - redMinThreshold)
+
+	return rand.Float64() >= pDrop
+}
+
+func (randomEarlyDropPolicy) victim(_ bufferView, incoming domain.Incedent) domain.Incedent {
+	return incoming
+}
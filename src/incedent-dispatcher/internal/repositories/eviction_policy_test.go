@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+var testStartTime = time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC)
+
+func TestRepositories(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Repositories Suite")
+}
+
+func newTestIncedent(id uint64, priority domain.Priority, offset time.Duration) domain.Incedent {
+	return domain.Incedent{
+		Id:           id,
+		Priority:     priority,
+		CreationTime: testStartTime.Add(offset),
+	}
+}
+
+var _ = Describe("EvictionPolicy", func() {
+	var (
+		buffered = []domain.Incedent{
+			newTestIncedent(1, 1, 2*time.Second),
+			newTestIncedent(2, 1, 1*time.Second),
+			newTestIncedent(3, 2, 0),
+			newTestIncedent(4, 3, 3*time.Second),
+		}
+		storage *BufferStorage
+	)
+
+	newStorage := func(name string) *BufferStorage {
+		policy, err := NewEvictionPolicy(name)
+		Expect(err).To(Succeed())
+		bs := NewBufferStorage(logger.InitZapWrapper(zap.NewNop()), uint64(len(buffered)), policy, nil, nil)
+		// admission is bypassed, random early drop may refuse to fill the buffer
+		for _, incedent := range buffered {
+			bs.putIncedent(incedent)
+		}
+
+		return bs
+	}
+
+	It("Unknown policy", func() {
+		_, err := NewEvictionPolicy("unknown")
+		Expect(err).NotTo(Succeed())
+	})
+
+	DescribeTable("EvictAndPut",
+		func(name string, incoming domain.Incedent, expected domain.Incedent) {
+			storage = newStorage(name)
+			Expect(storage.CheckAndPut(incoming)).NotTo(Succeed())
+			Expect(storage.EvictAndPut(incoming)).To(Equal(expected))
+		},
+		Entry("lower-priority", LowerPriorityPolicy,
+			newTestIncedent(5, 2, 4*time.Second), buffered[1]),
+		Entry("lower-priority same priority", LowerPriorityPolicy,
+			newTestIncedent(5, 1, 4*time.Second), buffered[1]),
+		Entry("lower-priority nothing to evict", LowerPriorityPolicy,
+			newTestIncedent(5, 0, 4*time.Second), newTestIncedent(5, 0, 4*time.Second)),
+		Entry("lowest-priority", LowestPriorityPolicy,
+			newTestIncedent(5, 3, 4*time.Second), buffered[1]),
+		Entry("lowest-priority higher buffered", LowestPriorityPolicy,
+			newTestIncedent(5, 0, 4*time.Second), newTestIncedent(5, 0, 4*time.Second)),
+		Entry("drop-oldest", DropOldestPolicy,
+			newTestIncedent(5, 0, 4*time.Second), buffered[2]),
+		Entry("drop-newest", DropNewestPolicy,
+			newTestIncedent(5, 0, 4*time.Second), buffered[3]),
+		Entry("reject", RejectPolicy,
+			newTestIncedent(5, 3, 4*time.Second), newTestIncedent(5, 3, 4*time.Second)),
+		Entry("random-early-drop", RandomEarlyDropPolicy,
+			newTestIncedent(5, 3, 4*time.Second), newTestIncedent(5, 3, 4*time.Second)),
+	)
+
+	It("Evicted incedent leaves the buffer", func() {
+		storage = newStorage(DropOldestPolicy)
+		incoming := newTestIncedent(5, 0, 4*time.Second)
+		Expect(storage.EvictAndPut(incoming)).To(Equal(buffered[2]))
+		Expect(storage.priorities()).To(Equal([]domain.Priority{0, 1, 3}))
+		Expect(storage.size()).To(Equal(len(buffered)))
+	})
+})
@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"container/heap"
+	"slices"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+)
+
+const (
+	oldestFirst = iota
+	newestFirst
+	heapsNumber
+)
+
+type incedentKey struct {
+	id       uint64
+	priority domain.Priority
+}
+
+func keyOf(incedent domain.Incedent) incedentKey {
+	return incedentKey{id: incedent.Id, priority: incedent.Priority}
+}
+
+// heapItem is shared between heaps of the same priority,
+// index keeps its position in each of them.
+type heapItem struct {
+	incedent domain.Incedent
+	index    [heapsNumber]int
+}
+
+// incedentHeap is an indexed heap ordered by creation time,
+// slot tells which index of heapItem it maintains.
+type incedentHeap struct {
+	slot  int
+	items []*heapItem
+}
+
+func (h *incedentHeap) Len() int {
+	return len(h.items)
+}
+
+func (h *incedentHeap) Less(i, j int) bool {
+	a, b := h.items[i].incedent, h.items[j].incedent
+	if h.slot == newestFirst {
+		return a.CreationTime.After(b.CreationTime)
+	}
+
+	return a.CreationTime.Before(b.CreationTime)
+}
+
+func (h *incedentHeap) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index[h.slot] = i
+	h.items[j].index[h.slot] = j
+}
+
+func (h *incedentHeap) Push(x any) {
+	item := x.(*heapItem)
+	item.index[h.slot] = len(h.items)
+	h.items = append(h.items, item)
+}
+
+func (h *incedentHeap) Pop() any {
+	last := len(h.items) - 1
+	item := h.items[last]
+	h.items[last] = nil
+	h.items = h.items[:last]
+	item.index[h.slot] = -1
+
+	return item
+}
+
+func (h *incedentHeap) top() *heapItem {
+	return h.items[0]
+}
+
+// priorityQueue holds incedents of one priority ordered
+// from the oldest and from the newest at the same time.
+type priorityQueue struct {
+	heaps [heapsNumber]incedentHeap
+}
+
+func newPriorityQueue() *priorityQueue {
+	pq := &priorityQueue{}
+	for slot := range pq.heaps {
+		pq.heaps[slot].slot = slot
+	}
+
+	return pq
+}
+
+func (pq *priorityQueue) len() int {
+	return pq.heaps[oldestFirst].Len()
+}
+
+func (pq *priorityQueue) push(item *heapItem) {
+	for slot := range pq.heaps {
+		heap.Push(&pq.heaps[slot], item)
+	}
+}
+
+func (pq *priorityQueue) remove(item *heapItem) {
+	for slot := range pq.heaps {
+		heap.Remove(&pq.heaps[slot], item.index[slot])
+	}
+}
+
+func (pq *priorityQueue) peek(slot int) (domain.Incedent, bool) {
+	if pq.len() == 0 {
+		return domain.Incedent{}, false
+	}
+
+	return pq.heaps[slot].top().incedent, true
+}
+
+// incedents returns queued incedents from the oldest one
+func (pq *priorityQueue) incedents() []domain.Incedent {
+	incedents := make([]domain.Incedent, 0, pq.len())
+	for _, item := range pq.heaps[oldestFirst].items {
+		incedents = append(incedents, item.incedent)
+	}
+	slices.SortFunc(incedents, func(a, b domain.Incedent) int {
+		return a.CreationTime.Compare(b.CreationTime)
+	})
+
+	return incedents
+}
+
+// drain empties the queue returning incedents from the oldest one
+func (pq *priorityQueue) drain() []domain.Incedent {
+	packet := pq.incedents()
+	for slot := range pq.heaps {
+		pq.heaps[slot].items = nil
+	}
+
+	return packet
+}
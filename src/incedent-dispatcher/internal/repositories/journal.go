@@ -0,0 +1,221 @@
+package repositories
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/benbjohnson/clock"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+)
+
+// journalCheckpointInterval is number of events between kept states, state of an event
+// is rebuilt from the nearest checkpoint, so it takes at most that many steps
+const journalCheckpointInterval = 64
+
+// Journal keeps ordered events of the dispatcher together with buffer and processors state,
+// at least last capacity events are kept. Events are kept as changes, state after an event
+// is rebuilt from the checkpoint before it, so memory doesn't grow with state size
+// of every event. Nil journal records nothing.
+type Journal struct {
+	clk      clock.Clock
+	capacity int
+	every    uint64
+
+	mu     sync.Mutex
+	seq    uint64
+	events []domain.JournalEvent
+	state  journalState
+	// checkpoints are states after every events of the interval, the first one is state before the oldest kept event
+	checkpoints []journalCheckpoint
+	// updated is closed and replaced on every event
+	updated chan struct{}
+}
+
+type journalCheckpoint struct {
+	seq   uint64
+	state journalState
+}
+
+func NewJournal(clk clock.Clock, capacity int) *Journal {
+	return &Journal{
+		clk:         clk,
+		capacity:    capacity,
+		every:       uint64(max(1, min(journalCheckpointInterval, capacity))),
+		state:       newJournalState(),
+		checkpoints: []journalCheckpoint{{seq: 0, state: newJournalState()}},
+		updated:     make(chan struct{}),
+	}
+}
+
+// Record applies event to the state and journals it, processorID is ignored for events without processor
+func (j *Journal) Record(eventType domain.EventType, incedent domain.Incedent, processorID uint64) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.seq++
+	event := domain.JournalEvent{
+		Seq:      j.seq,
+		Time:     j.clk.Now(),
+		Type:     eventType,
+		Incedent: incedent,
+	}
+	if eventType == domain.EventStarted || eventType == domain.EventAttemptFailed || eventType == domain.EventFinished {
+		event.ProcessorID = processorID
+	}
+	j.state.apply(event)
+	j.events = append(j.events, event)
+	if j.seq%j.every == 0 {
+		j.checkpoints = append(j.checkpoints, journalCheckpoint{seq: j.seq, state: j.state.clone()})
+	}
+	// old events are dropped in bulk up to a checkpoint, so recording takes amortized O(1)
+	if len(j.events) >= 2*j.capacity {
+		j.compact()
+	}
+
+	close(j.updated)
+	j.updated = make(chan struct{})
+}
+
+// Enabled is false for nil journal
+func (j *Journal) Enabled() bool {
+	return j != nil
+}
+
+// Events returns kept events starting from sequence number from,
+// returned channel is closed when the next event is recorded, it is nil for nil journal
+func (j *Journal) Events(from uint64) ([]domain.JournalEvent, <-chan struct{}) {
+	if j == nil {
+		return nil, nil
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if len(j.events) == 0 || from > j.seq {
+		return nil, j.updated
+	}
+	from = max(from, j.events[0].Seq)
+	// the last checkpoint before the first returned event
+	i, _ := slices.BinarySearchFunc(j.checkpoints, from, func(cp journalCheckpoint, seq uint64) int {
+		return cmp.Compare(cp.seq, seq)
+	})
+	checkpoint := j.checkpoints[i-1]
+
+	state := checkpoint.state.clone()
+	first := int(checkpoint.seq + 1 - j.events[0].Seq)
+	events := make([]domain.JournalEvent, 0, j.seq-from+1)
+	for _, event := range j.events[first:] {
+		state.apply(event)
+		if event.Seq < from {
+			continue
+		}
+		event.Buffer = state.bufferState()
+		event.Processors = state.processorsState()
+		events = append(events, event)
+	}
+
+	return events, j.updated
+}
+
+// compact drops events before the latest checkpoint which keeps at least capacity events
+func (j *Journal) compact() {
+	i, _ := slices.BinarySearchFunc(j.checkpoints, j.seq-uint64(j.capacity)+1, func(cp journalCheckpoint, seq uint64) int {
+		return cmp.Compare(cp.seq, seq)
+	})
+	if i <= 1 {
+		return
+	}
+	base := j.checkpoints[i-1]
+	j.events = slices.Clone(j.events[base.seq+1-j.events[0].Seq:])
+	j.checkpoints = slices.Clone(j.checkpoints[i-1:])
+}
+
+// journalState is buffer and processors state rebuilt from events
+type journalState struct {
+	buffer     map[incedentKey]domain.Incedent
+	processors map[uint64][]domain.Incedent
+}
+
+func newJournalState() journalState {
+	return journalState{
+		buffer:     make(map[incedentKey]domain.Incedent),
+		processors: make(map[uint64][]domain.Incedent),
+	}
+}
+
+func (s journalState) apply(event domain.JournalEvent) {
+	key := keyOf(event.Incedent)
+	switch event.Type {
+	case domain.EventBuffered:
+		s.buffer[key] = event.Incedent
+	case domain.EventEvicted:
+		delete(s.buffer, key)
+	case domain.EventStarted:
+		delete(s.buffer, key)
+		s.processors[event.ProcessorID] = append(s.processors[event.ProcessorID], event.Incedent)
+	case domain.EventAttemptFailed, domain.EventFinished:
+		s.processors[event.ProcessorID] = removeIncedent(s.processors[event.ProcessorID], key)
+	case domain.EventRejected:
+		delete(s.buffer, key)
+		for id, incedents := range s.processors {
+			s.processors[id] = removeIncedent(incedents, key)
+		}
+	}
+}
+
+func (s journalState) clone() journalState {
+	clone := journalState{
+		buffer:     maps.Clone(s.buffer),
+		processors: make(map[uint64][]domain.Incedent, len(s.processors)),
+	}
+	for id, incedents := range s.processors {
+		clone.processors[id] = slices.Clone(incedents)
+	}
+
+	return clone
+}
+
+func (s journalState) bufferState() []domain.Incedent {
+	buffer := make([]domain.Incedent, 0, len(s.buffer))
+	for _, incedent := range s.buffer {
+		buffer = append(buffer, incedent)
+	}
+	slices.SortFunc(buffer, func(a, b domain.Incedent) int {
+		if a.Priority != b.Priority {
+			return cmp.Compare(b.Priority, a.Priority)
+		}
+		if c := a.CreationTime.Compare(b.CreationTime); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	return buffer
+}
+
+func (s journalState) processorsState() []domain.ProcessorState {
+	processors := make([]domain.ProcessorState, 0, len(s.processors))
+	for id, incedents := range s.processors {
+		processors = append(processors, domain.ProcessorState{
+			Id:        id,
+			Incedents: slices.Clone(incedents),
+		})
+	}
+	slices.SortFunc(processors, func(a, b domain.ProcessorState) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	return processors
+}
+
+func removeIncedent(incedents []domain.Incedent, key incedentKey) []domain.Incedent {
+	return slices.DeleteFunc(incedents, func(incedent domain.Incedent) bool {
+		return keyOf(incedent) == key
+	})
+}
@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+)
+
+var _ = Describe("Journal", func() {
+	var (
+		clk     *clock.Mock
+		journal *Journal
+		first   = domain.Incedent{Id: 1, Priority: 1}
+		second  = domain.Incedent{Id: 1, Priority: 2}
+	)
+
+	BeforeEach(func() {
+		clk = clock.NewMock()
+		journal = NewJournal(clk, 3)
+	})
+
+	It("Keeps state after every event", func() {
+		journal.Record(domain.EventBuffered, first, 0)
+		journal.Record(domain.EventBuffered, second, 0)
+		clk.Add(time.Second)
+		journal.Record(domain.EventStarted, second, 7)
+
+		events, _ := journal.Events(0)
+		Expect(events).To(HaveLen(3))
+		Expect(events[1].Buffer).To(Equal([]domain.Incedent{second, first}))
+		started := events[2]
+		Expect(started.Seq).To(Equal(uint64(3)))
+		Expect(started.Time).To(Equal(clk.Now()))
+		Expect(started.ProcessorID).To(Equal(uint64(7)))
+		Expect(started.Buffer).To(Equal([]domain.Incedent{first}))
+		Expect(started.Processors).To(Equal([]domain.ProcessorState{{Id: 7, Incedents: []domain.Incedent{second}}}))
+
+		journal.Record(domain.EventFinished, second, 7)
+		journal.Record(domain.EventRejected, first, 0)
+		events, _ = journal.Events(5)
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Buffer).To(BeEmpty())
+		Expect(events[0].Processors).To(Equal([]domain.ProcessorState{{Id: 7, Incedents: []domain.Incedent{}}}))
+	})
+
+	It("Drops old events", func() {
+		for range 7 {
+			journal.Record(domain.EventArrived, first, 0)
+		}
+
+		events, _ := journal.Events(0)
+		Expect(len(events)).To(BeNumerically(">=", 3))
+		Expect(events[len(events)-1].Seq).To(Equal(uint64(7)))
+		Expect(events[0].Seq).To(Equal(uint64(7 - len(events) + 1)))
+	})
+
+	It("Rebuilds state of kept events", func() {
+		// full journal keeps every event and replays it from the start
+		full := NewJournal(clk, 1000)
+		record := func(eventType domain.EventType, incedent domain.Incedent, processorID uint64) {
+			journal.Record(eventType, incedent, processorID)
+			full.Record(eventType, incedent, processorID)
+		}
+		for id := range uint64(10) {
+			incedent := domain.Incedent{Id: id, Priority: domain.Priority(id % 3)}
+			record(domain.EventBuffered, incedent, 0)
+			record(domain.EventStarted, incedent, id%2)
+			if id%3 == 0 {
+				record(domain.EventAttemptFailed, incedent, id%2)
+				record(domain.EventRejected, incedent, 0)
+				continue
+			}
+			record(domain.EventFinished, incedent, id%2)
+		}
+
+		expected, _ := full.Events(0)
+		last := uint64(len(expected))
+		for from := uint64(0); from <= last; from++ {
+			events, _ := journal.Events(from)
+			Expect(len(events)).To(BeNumerically(">=", min(3, last-from+1)))
+			first := events[0].Seq
+			Expect(events).To(Equal(expected[first-1:]))
+		}
+	})
+
+	It("Notifies about new events", func() {
+		events, updated := journal.Events(1)
+		Expect(events).To(BeEmpty())
+		Expect(updated).NotTo(BeClosed())
+
+		journal.Record(domain.EventArrived, first, 0)
+		Expect(updated).To(BeClosed())
+		events, _ = journal.Events(1)
+		Expect(events).To(HaveLen(1))
+	})
+
+	It("Nil journal records nothing", func() {
+		var journal *Journal
+		journal.Record(domain.EventArrived, first, 0)
+		events, updated := journal.Events(0)
+		Expect(events).To(BeEmpty())
+		Expect(updated).To(BeNil())
+	})
+
+	It("Writes jsonl", func() {
+		journal.Record(domain.EventBuffered, first, 0)
+		journal.Record(domain.EventStarted, first, 2)
+		path := filepath.Join(GinkgoT().TempDir(), "out", "journal.jsonl")
+		writer, err := NewJournalWriter(path)
+		Expect(err).To(Succeed())
+		events, _ := journal.Events(0)
+		Expect(writer.Write(events)).To(Succeed())
+		Expect(writer.Close()).To(Succeed())
+
+		file, err := os.Open(path)
+		Expect(err).To(Succeed())
+		defer file.Close()
+		var lines []journalEventJSON
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			var line journalEventJSON
+			Expect(json.Unmarshal(scanner.Bytes(), &line)).To(Succeed())
+			lines = append(lines, line)
+		}
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[1].Type).To(Equal("started"))
+		Expect(lines[1].ProcessorID).To(Equal(uint64(2)))
+		Expect(lines[1].Processors[0].Incedents[0].Id).To(Equal(uint64(1)))
+	})
+})
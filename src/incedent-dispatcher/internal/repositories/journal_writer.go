@@ -0,0 +1,112 @@
+package repositories
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+)
+
+type journalIncedentJSON struct {
+	Id       uint64    `json:"id"`
+	Priority uint64    `json:"priority"`
+	Time     time.Time `json:"time"`
+}
+
+type processorStateJSON struct {
+	Id        uint64                `json:"id"`
+	Incedents []journalIncedentJSON `json:"incedents"`
+}
+
+type journalEventJSON struct {
+	Seq         uint64                `json:"seq"`
+	Time        time.Time             `json:"time"`
+	Type        string                `json:"type"`
+	Incedent    journalIncedentJSON   `json:"incedent"`
+	ProcessorID uint64                `json:"processor_id,omitempty"`
+	Buffer      []journalIncedentJSON `json:"buffer"`
+	Processors  []processorStateJSON  `json:"processors"`
+}
+
+// JournalWriter writes journal events to jsonl file, one event per line
+type JournalWriter struct {
+	file *os.File
+	w    *bufio.Writer
+}
+
+// NewJournalWriter creates file, existing one is truncated
+func NewJournalWriter(path string) (*JournalWriter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create journal directory: %w", err)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create journal: %w", err)
+	}
+
+	return &JournalWriter{
+		file: file,
+		w:    bufio.NewWriter(file),
+	}, nil
+}
+
+func (jw *JournalWriter) Write(events []domain.JournalEvent) error {
+	encoder := json.NewEncoder(jw.w)
+	for _, event := range events {
+		if err := encoder.Encode(toJournalEventJSON(event)); err != nil {
+			return fmt.Errorf("failed to write journal: %w", err)
+		}
+	}
+	if err := jw.w.Flush(); err != nil {
+		return fmt.Errorf("failed to write journal: %w", err)
+	}
+
+	return nil
+}
+
+func (jw *JournalWriter) Close() error {
+	if err := jw.w.Flush(); err != nil {
+		return fmt.Errorf("failed to write journal: %w", err)
+	}
+
+	return jw.file.Close()
+}
+
+func toJournalEventJSON(event domain.JournalEvent) journalEventJSON {
+	out := journalEventJSON{
+		Seq:         event.Seq,
+		Time:        event.Time,
+		Type:        event.Type.String(),
+		Incedent:    toJournalIncedentJSON(event.Incedent),
+		ProcessorID: event.ProcessorID,
+		Buffer:      make([]journalIncedentJSON, 0, len(event.Buffer)),
+		Processors:  make([]processorStateJSON, 0, len(event.Processors)),
+	}
+	for _, incedent := range event.Buffer {
+		out.Buffer = append(out.Buffer, toJournalIncedentJSON(incedent))
+	}
+	for _, processor := range event.Processors {
+		state := processorStateJSON{
+			Id:        processor.Id,
+			Incedents: make([]journalIncedentJSON, 0, len(processor.Incedents)),
+		}
+		for _, incedent := range processor.Incedents {
+			state.Incedents = append(state.Incedents, toJournalIncedentJSON(incedent))
+		}
+		out.Processors = append(out.Processors, state)
+	}
+
+	return out
+}
+
+func toJournalIncedentJSON(incedent domain.Incedent) journalIncedentJSON {
+	return journalIncedentJSON{
+		Id:       incedent.Id,
+		Priority: uint64(incedent.Priority),
+		Time:     incedent.CreationTime,
+	}
+}
@@ -1,6 +1,8 @@
 package repositories
 
 import (
+	"cmp"
+	"slices"
 	"sync"
 	"time"
 
@@ -10,54 +12,70 @@ import (
 	"go.uber.org/zap"
 )
 
-type incedentStatus int
-
-const (
-	InBuffer incedentStatus = iota
-	InProcessing
-	Processed
-	Rejected
-)
-
 type incedentInfo struct {
-	status          incedentStatus
+	status          domain.IncedentStatus
 	processorID     uint64
+	failedAttempts  int
+	evicted         bool
 	received        time.Time
 	startProcessing time.Time
 	endProcessing   time.Time
+	// excluded incedents were received before statistics start, they are kept for status queries
+	excluded bool
+	// changed is closed on the next status change, it's made only when status is watched
+	changed chan struct{}
 }
 
-type processorInfo struct {
-	regTime time.Time
-	inWork  time.Duration
+func (ii *incedentInfo) setStatus(status domain.IncedentStatus) {
+	ii.status = status
+	if ii.changed != nil {
+		close(ii.changed)
+		ii.changed = nil
+	}
 }
 
-type producerStats struct {
-	total                int
-	rejected             int
-	pRejected            float64
-	timeInSystem         time.Duration
-	timeInProcessing     time.Duration
-	timeInBuffer         time.Duration
-	dispTimeInBuffer     float64
-	dispTimeInProcessing float64
+type processorInfo struct {
+	capacity       int
+	regTime        time.Time
+	deregTime      time.Time
+	expired        bool
+	failedAttempts int
+	// failedWork is time spent on attempts which failed, they aren't among processed incedents
+	failedWork time.Duration
 }
 
 type MetricsStorage struct {
-	log *logger.Logger
-	clk clock.Clock
+	log       *logger.Logger
+	clk       clock.Clock
+	precision domain.Precision
+	warmUp    domain.WarmUp
 
 	iMu        sync.Mutex
 	incedents  map[domain.Priority]map[uint64]*incedentInfo
+	received   int
+	warmedUp   bool
+	since      time.Time
 	pMu        sync.Mutex
 	processors map[uint64]processorInfo
+	changes    []domain.ConfigChange
 }
 
-func NewMetricsStorage(log *logger.Logger, clk clock.Clock) *MetricsStorage {
+// NewMetricsStorage creates storage, confidence intervals are computed with the given precision,
+// statistics are reset once warm-up is over
+func NewMetricsStorage(
+	log *logger.Logger,
+	clk clock.Clock,
+	precision domain.Precision,
+	warmUp domain.WarmUp,
+) *MetricsStorage {
 	return &MetricsStorage{
 		log:        log,
 		clk:        clk,
+		precision:  precision,
+		warmUp:     warmUp,
 		incedents:  make(map[domain.Priority]map[uint64]*incedentInfo),
+		warmedUp:   warmUp == domain.WarmUp{},
+		since:      clk.Now(),
 		processors: make(map[uint64]processorInfo),
 	}
 }
@@ -67,17 +85,48 @@ func (ms *MetricsStorage) RegisteredProcessor(processor domain.IncedentProcessor
 	defer ms.pMu.Unlock()
 
 	ms.processors[processor.Id] = processorInfo{
-		regTime: ms.clk.Now(),
+		capacity: int(max(processor.Capacity, 1)),
+		regTime:  ms.clk.Now(),
+	}
+}
+
+func (ms *MetricsStorage) DeregisteredProcessor(processor domain.IncedentProcessor, expired bool) {
+	ms.pMu.Lock()
+	defer ms.pMu.Unlock()
+
+	info, ok := ms.processors[processor.Id]
+	if !ok {
+		return
+	}
+	info.deregTime = ms.clk.Now()
+	info.expired = expired
+	ms.processors[processor.Id] = info
+}
+
+// ConfigChanged records runtime change of dispatcher settings
+func (ms *MetricsStorage) ConfigChanged(setting, value string) {
+	ms.pMu.Lock()
+	defer ms.pMu.Unlock()
+
+	change := domain.ConfigChange{
+		Time:    ms.clk.Now(),
+		Setting: setting,
+		Value:   value,
 	}
+	ms.changes = append(ms.changes, change)
+	ms.log.Info("Dispatcher settings changed", zap.Stringer("change", change))
 }
 
 func (ms *MetricsStorage) ReceivedIncedent(incedent domain.Incedent) {
 	ms.iMu.Lock()
 	defer ms.iMu.Unlock()
 
+	ms.checkWarmUp()
 	info := ms.getIncedentInfo(incedent.Priority, incedent.Id)
-	info.status = InBuffer
+	info.setStatus(domain.InBuffer)
 	info.received = incedent.CreationTime
+	ms.received++
+	ms.checkWarmUp()
 }
 
 func (ms *MetricsStorage) ProcessInedent(incedent domain.Incedent, processor domain.IncedentProcessor) {
@@ -85,7 +134,7 @@ func (ms *MetricsStorage) ProcessInedent(incedent domain.Incedent, processor dom
 	defer ms.iMu.Unlock()
 
 	info := ms.getIncedentInfo(incedent.Priority, incedent.Id)
-	info.status = InProcessing
+	info.setStatus(domain.InProcessing)
 	info.startProcessing = ms.clk.Now()
 	info.processorID = processor.Id
 }
@@ -95,53 +144,233 @@ func (ms *MetricsStorage) IncedentProcessed(incedent domain.Incedent, processor
 	defer ms.iMu.Unlock()
 
 	info := ms.getIncedentInfo(incedent.Priority, incedent.Id)
-	info.status = Processed
+	info.setStatus(domain.Processed)
 	info.endProcessing = ms.clk.Now()
 }
 
+// IncedentAttemptFailed counts transport failure, incedent will be sent to another processor
+func (ms *MetricsStorage) IncedentAttemptFailed(incedent domain.Incedent, processor domain.IncedentProcessor, attempt int) {
+	ms.iMu.Lock()
+	info := ms.getIncedentInfo(incedent.Priority, incedent.Id)
+	info.setStatus(domain.InBuffer)
+	info.failedAttempts = attempt
+	start := info.startProcessing
+	ms.iMu.Unlock()
+
+	ms.pMu.Lock()
+	defer ms.pMu.Unlock()
+	if pInfo, ok := ms.processors[processor.Id]; ok {
+		pInfo.failedAttempts++
+		pInfo.failedWork += ms.clk.Now().Sub(later(start, ms.since))
+		ms.processors[processor.Id] = pInfo
+	}
+}
+
+// IncedentFailed keeps time processor spent on the last attempt, incedent won't be sent again
+func (ms *MetricsStorage) IncedentFailed(incedent domain.Incedent, processor domain.IncedentProcessor) {
+	ms.iMu.Lock()
+	start := ms.getIncedentInfo(incedent.Priority, incedent.Id).startProcessing
+	ms.iMu.Unlock()
+
+	ms.pMu.Lock()
+	defer ms.pMu.Unlock()
+	if pInfo, ok := ms.processors[processor.Id]; ok {
+		pInfo.failedWork += ms.clk.Now().Sub(later(start, ms.since))
+		ms.processors[processor.Id] = pInfo
+	}
+}
+
+// IncedentEvicted marks incedent pushed out of the buffer or refused by eviction policy
+func (ms *MetricsStorage) IncedentEvicted(incedent domain.Incedent) {
+	ms.iMu.Lock()
+	defer ms.iMu.Unlock()
+
+	info := ms.getIncedentInfo(incedent.Priority, incedent.Id)
+	info.evicted = true
+}
+
 func (ms *MetricsStorage) IncedentRejected(incedent domain.Incedent) {
 	ms.iMu.Lock()
 	defer ms.iMu.Unlock()
 
 	info := ms.getIncedentInfo(incedent.Priority, incedent.Id)
-	info.status = Rejected
+	info.setStatus(domain.Rejected)
 }
 
-func (ms *MetricsStorage) PrintStatistics() {
+func (ms *MetricsStorage) IncedentStatus(priority domain.Priority, id uint64) (domain.IncedentStatus, bool) {
 	ms.iMu.Lock()
 	defer ms.iMu.Unlock()
-	ms.pMu.Lock()
-	defer ms.pMu.Unlock()
-	currTime := ms.clk.Now()
+
+	info, ok := ms.incedents[priority][id]
+	if !ok {
+		return 0, false
+	}
+
+	return info.status, true
+}
+
+// WatchIncedentStatus returns status of the incedent and channel closed when the status changes
+func (ms *MetricsStorage) WatchIncedentStatus(priority domain.Priority, id uint64) (domain.IncedentStatus, <-chan struct{}, bool) {
+	ms.iMu.Lock()
+	defer ms.iMu.Unlock()
+
+	info, ok := ms.incedents[priority][id]
+	if !ok {
+		return 0, nil, false
+	}
+	if info.changed == nil {
+		info.changed = make(chan struct{})
+	}
+
+	return info.status, info.changed, true
+}
+
+func (ms *MetricsStorage) PrintStatistics() {
+	report := ms.Report()
 	ms.log.Info("=== Statistics ===")
-	for priority, incedents := range ms.incedents {
-		stats := getIncedentStats(incedents, ms.processors)
+	for _, stats := range report.Priorities {
 		ms.log.Info("Producer statistics",
-			zap.Any("priority", priority),
-			zap.Int("total incedents", stats.total),
-			zap.Int("number rejected", stats.rejected),
-			zap.Float64("pRejected", stats.pRejected),
-			zap.Stringer("timeInSystem", stats.timeInSystem),
-			zap.Stringer("timeInProcessing", stats.timeInProcessing),
-			zap.Stringer("timeInBuffer", stats.timeInBuffer),
-			// zap.Float64("dispTimeInBuffer", stats.dispTimeInBuffer),
-			// zap.Float64("dispTimeInProcessing", stats.dispTimeInProcessing),
+			zap.Any("priority", stats.Priority),
+			zap.Int("total incedents", stats.Total),
+			zap.Int("number rejected", stats.Rejected),
+			zap.Int("number evicted", stats.Evicted),
+			zap.Int("number retried", stats.Retried),
+			zap.Int("number in progress", stats.InProgress),
+			zap.Float64("pRejected", stats.PRejected),
+			zap.Float64("pRejectedHalfWidth", stats.PRejectedInterval.HalfWidth),
+			zap.Int("requiredIncedents", stats.RequiredIncedents),
+			zap.Stringer("timeInSystem", stats.TimeInSystem),
+			zap.Float64("timeInSystemHalfWidth", stats.TimeInSystemInterval.HalfWidth),
+			zap.Stringer("timeInProcessing", stats.TimeInProcessing.Mean),
+			zap.Stringer("timeInBuffer", stats.TimeInBuffer.Mean),
+			zap.Float64("dispTimeInBuffer", stats.TimeInBuffer.Variance),
+			zap.Float64("dispTimeInProcessing", stats.TimeInProcessing.Variance),
 		)
 	}
 
-	for id, info := range ms.processors {
-		processorOn := currTime.Sub(info.regTime)
+	for _, stats := range report.Processors {
 		ms.log.Info("Processors statistics",
-			zap.Uint64("id", id),
-			zap.Stringer("start time", info.regTime),
-			zap.Stringer("end time", currTime),
-			zap.Stringer("processorOn", processorOn),
-			zap.Stringer("inWork", info.inWork),
-			zap.Float64("utilityKoef", float64(info.inWork.Milliseconds())/float64(processorOn.Milliseconds())),
+			zap.Uint64("id", stats.Id),
+			zap.Stringer("start time", stats.RegTime),
+			zap.Stringer("end time", stats.EndTime),
+			zap.Bool("lease expired", stats.LeaseExpired),
+			zap.Int("slots", stats.Capacity),
+			zap.Stringer("processorOn", stats.Uptime),
+			zap.Stringer("inWork", stats.InWork),
+			zap.Int("failedAttempts", stats.FailedAttempts),
+			zap.Float64("utilityKoef", stats.Utilization),
 		)
 	}
 }
 
+// Report makes snapshot of statistics, priorities and processors are sorted,
+// storage isn't changed, so it is safe to call any time
+func (ms *MetricsStorage) Report() domain.Report {
+	ms.iMu.Lock()
+	defer ms.iMu.Unlock()
+	ms.pMu.Lock()
+	defer ms.pMu.Unlock()
+
+	report := domain.Report{
+		Time:       ms.clk.Now(),
+		Since:      ms.since,
+		Precision:  ms.precision,
+		Priorities: make([]domain.PriorityReport, 0, len(ms.incedents)),
+		Processors: make([]domain.ProcessorReport, 0, len(ms.processors)),
+	}
+	inWork := make(map[uint64]time.Duration, len(ms.processors))
+	for priority, incedents := range ms.incedents {
+		report.Priorities = append(report.Priorities, getIncedentStats(priority, incedents, inWork, ms.since, ms.precision))
+	}
+	slices.SortFunc(report.Priorities, func(a, b domain.PriorityReport) int {
+		return cmp.Compare(a.Priority, b.Priority)
+	})
+
+	for id, info := range ms.processors {
+		endTime := report.Time
+		if !info.deregTime.IsZero() {
+			endTime = info.deregTime
+		}
+		stats := domain.ProcessorReport{
+			Id:             id,
+			Capacity:       info.capacity,
+			RegTime:        info.regTime,
+			EndTime:        endTime,
+			Deregistered:   !info.deregTime.IsZero(),
+			LeaseExpired:   info.expired,
+			Uptime:         endTime.Sub(later(info.regTime, ms.since)),
+			InWork:         inWork[id] + info.failedWork,
+			FailedAttempts: info.failedAttempts,
+		}
+		// utilization is per slot, processor works on several incedents in parallel
+		if slotsOn := stats.Uptime * time.Duration(info.capacity); slotsOn > 0 {
+			stats.Utilization = stats.InWork.Seconds() / slotsOn.Seconds()
+		}
+		report.Processors = append(report.Processors, stats)
+	}
+	slices.SortFunc(report.Processors, func(a, b domain.ProcessorReport) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+	report.ConfigChanges = slices.Clone(ms.changes)
+
+	return report
+}
+
+// Reset excludes incedents received so far from statistics, utilization of processors
+// is measured from now on, deregistered processors are forgotten
+func (ms *MetricsStorage) Reset() {
+	ms.iMu.Lock()
+	defer ms.iMu.Unlock()
+	ms.pMu.Lock()
+	defer ms.pMu.Unlock()
+
+	ms.reset()
+	ms.log.Info("Statistics reset")
+}
+
+// checkWarmUp resets statistics if warm-up is over, iMu must be held
+func (ms *MetricsStorage) checkWarmUp() {
+	if !ms.warmUpOver() {
+		return
+	}
+	ms.pMu.Lock()
+	defer ms.pMu.Unlock()
+
+	ms.reset()
+	ms.log.Info("Warm-up finished, statistics reset", zap.Int("received incedents", ms.received))
+}
+
+func (ms *MetricsStorage) warmUpOver() bool {
+	if ms.warmedUp {
+		return false
+	}
+	if ms.warmUp.Incedents > 0 && ms.received >= ms.warmUp.Incedents {
+		return true
+	}
+
+	return ms.warmUp.Duration > 0 && ms.clk.Since(ms.since) >= ms.warmUp.Duration
+}
+
+// reset must be called with both mutexes held
+func (ms *MetricsStorage) reset() {
+	for _, incedents := range ms.incedents {
+		for _, info := range incedents {
+			info.excluded = true
+		}
+	}
+	for id, info := range ms.processors {
+		if !info.deregTime.IsZero() {
+			delete(ms.processors, id)
+			continue
+		}
+		info.failedAttempts = 0
+		info.failedWork = 0
+		ms.processors[id] = info
+	}
+	ms.since = ms.clk.Now()
+	ms.warmedUp = true
+}
+
 func (ms *MetricsStorage) getIncedentInfo(priority domain.Priority, id uint64) *incedentInfo {
 	val, ok := ms.incedents[priority]
 	if !ok {
@@ -158,31 +387,77 @@ func (ms *MetricsStorage) getIncedentInfo(priority domain.Priority, id uint64) *
 	return currInfo
 }
 
-func getIncedentStats(incedents map[uint64]*incedentInfo, processors map[uint64]processorInfo) producerStats {
-	stats := producerStats{}
+// getIncedentStats computes statistics of one priority and adds processing time to inWork
+func getIncedentStats(
+	priority domain.Priority,
+	incedents map[uint64]*incedentInfo,
+	inWork map[uint64]time.Duration,
+	since time.Time,
+	precision domain.Precision,
+) domain.PriorityReport {
+	stats := domain.PriorityReport{Priority: priority}
 	var (
-		totalTimeInBuffer     time.Duration
-		totalTimeInProcessing time.Duration
+		timesInBuffer     = make([]time.Duration, 0, len(incedents))
+		timesInProcessing = make([]time.Duration, 0, len(incedents))
+		processed         = make([]*incedentInfo, 0, len(incedents))
 	)
 	for _, incedent := range incedents {
-		stats.total++
-		if incedent.status != Processed {
-			stats.rejected++
+		if incedent.excluded {
+			// processor was still busy with it after the start
+			if incedent.status == domain.Processed && incedent.endProcessing.After(since) {
+				inWork[incedent.processorID] += incedent.endProcessing.Sub(later(incedent.startProcessing, since))
+			}
+			continue
+		}
+		stats.Total++
+		if incedent.evicted {
+			stats.Evicted++
+		}
+		if incedent.failedAttempts > 0 {
+			stats.Retried++
+		}
+		switch incedent.status {
+		case domain.Rejected:
+			stats.Rejected++
+			continue
+		case domain.Processed:
+			stats.Processed++
+		default:
+			stats.InProgress++
 			continue
 		}
 		timeInBuffer := incedent.startProcessing.Sub(incedent.received)
 		timeProcessing := incedent.endProcessing.Sub(incedent.startProcessing)
-		totalTimeInBuffer += timeInBuffer
-		totalTimeInProcessing += timeProcessing
-
-		old := processors[incedent.processorID]
-		old.inWork += timeProcessing
-		processors[incedent.processorID] = old
-	}
-	stats.pRejected = float64(stats.rejected) / float64(stats.total)
-	processed := stats.total - stats.rejected
-	stats.timeInBuffer = time.Duration(totalTimeInBuffer.Milliseconds()/int64(processed)) * time.Millisecond
-	stats.timeInProcessing = time.Duration(totalTimeInProcessing.Milliseconds()/int64(processed)) * time.Millisecond
-	stats.timeInSystem = stats.timeInBuffer + stats.timeInProcessing
+		timesInBuffer = append(timesInBuffer, timeInBuffer)
+		timesInProcessing = append(timesInProcessing, timeProcessing)
+		inWork[incedent.processorID] += timeProcessing
+		processed = append(processed, incedent)
+	}
+	if finished := stats.Processed + stats.Rejected; finished > 0 {
+		stats.PRejected = float64(stats.Rejected) / float64(finished)
+		stats.PRejectedInterval = domain.NewProportionInterval(stats.Rejected, finished, precision.Confidence)
+		stats.RequiredIncedents = domain.RequiredIncedents(stats.PRejected, precision.Confidence, precision.Accuracy)
+	}
+	// batches are made in order of processing, neighbour incedents influence each other
+	slices.SortFunc(processed, func(a, b *incedentInfo) int {
+		return a.endProcessing.Compare(b.endProcessing)
+	})
+	timesInSystem := make([]float64, 0, len(processed))
+	for _, incedent := range processed {
+		timesInSystem = append(timesInSystem, incedent.endProcessing.Sub(incedent.received).Seconds())
+	}
+	stats.TimeInSystemInterval = domain.NewBatchMeansInterval(timesInSystem, precision.Confidence)
+	stats.TimeInBuffer = domain.NewTimeStats(timesInBuffer)
+	stats.TimeInProcessing = domain.NewTimeStats(timesInProcessing)
+	stats.TimeInSystem = stats.TimeInBuffer.Mean + stats.TimeInProcessing.Mean
+
 	return stats
 }
+
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
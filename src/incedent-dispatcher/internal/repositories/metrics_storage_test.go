@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+var _ = Describe("MetricsStorage", func() {
+	var (
+		clk       *clock.Mock
+		storage   *MetricsStorage
+		processor = domain.IncedentProcessor{Id: 1}
+	)
+
+	newStorage := func(warmUp domain.WarmUp) {
+		storage = NewMetricsStorage(logger.InitZapWrapper(zap.NewNop()), clk, domain.Precision{Confidence: 0.95}, warmUp)
+		storage.RegisteredProcessor(processor)
+	}
+
+	process := func(id uint64, work time.Duration) {
+		incedent := domain.Incedent{Id: id, Priority: 1, CreationTime: clk.Now()}
+		storage.ReceivedIncedent(incedent)
+		storage.ProcessInedent(incedent, processor)
+		clk.Add(work)
+		storage.IncedentProcessed(incedent, processor)
+	}
+
+	BeforeEach(func() {
+		clk = clock.NewMock()
+	})
+
+	It("Resets statistics", func() {
+		newStorage(domain.WarmUp{})
+		process(1, time.Second)
+		old := domain.Incedent{Id: 2, Priority: 1, CreationTime: clk.Now()}
+		storage.ReceivedIncedent(old)
+		storage.ProcessInedent(old, processor)
+		clk.Add(time.Second)
+
+		storage.Reset()
+		clk.Add(time.Second)
+		storage.IncedentProcessed(old, processor)
+		process(3, time.Second)
+		clk.Add(2 * time.Second)
+
+		report := storage.Report()
+		Expect(report.Since).To(Equal(clk.Now().Add(-4 * time.Second)))
+		Expect(report.Priorities).To(HaveLen(1))
+		Expect(report.Priorities[0].Total).To(Equal(1))
+		Expect(report.Priorities[0].TimeInProcessing.Mean).To(Equal(time.Second))
+		// processing of the old incedent after reset is counted too
+		Expect(report.Processors[0].InWork).To(Equal(2 * time.Second))
+		Expect(report.Processors[0].Uptime).To(Equal(4 * time.Second))
+
+		status, ok := storage.IncedentStatus(1, 1)
+		Expect(ok).To(BeTrue())
+		Expect(status).To(Equal(domain.Processed))
+	})
+
+	It("Excludes warm-up incedents", func() {
+		newStorage(domain.WarmUp{Incedents: 2})
+		for id := range uint64(5) {
+			process(id, time.Second)
+		}
+
+		report := storage.Report()
+		Expect(report.Priorities[0].Total).To(Equal(3))
+		Expect(report.Processors[0].Uptime).To(Equal(4 * time.Second))
+	})
+
+	It("Excludes warm-up period", func() {
+		newStorage(domain.WarmUp{Duration: 3 * time.Second})
+		for id := range uint64(5) {
+			process(id, time.Second)
+		}
+		clk.Add(time.Second)
+
+		report := storage.Report()
+		Expect(report.Priorities[0].Total).To(Equal(2))
+		Expect(report.Processors[0].Utilization).To(BeNumerically("~", 2.0/3))
+	})
+})
@@ -3,54 +3,266 @@ package repositories
 import (
 	"slices"
 	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
 
 	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
 )
 
 type ProcessorStorage struct {
-	mu             sync.RWMutex
-	processors     []domain.ProcessorClientInfo
-	busyProcessors map[uint64]bool
+	clk      clock.Clock
+	strategy selectionStrategy
+
+	mu         sync.RWMutex
+	processors []*processorState
+	lastSeq    uint64
+	leases     map[uint64]time.Time
+	// freed is closed and replaced when slots may have become free
+	freed chan struct{}
 }
 
-func NewProcessorStorage() *ProcessorStorage {
+func NewProcessorStorage(clk clock.Clock, strategy selectionStrategy) *ProcessorStorage {
 	return &ProcessorStorage{
-		processors:     make([]domain.ProcessorClientInfo, 0),
-		busyProcessors: make(map[uint64]bool),
+		clk:        clk,
+		strategy:   strategy,
+		processors: make([]*processorState, 0),
+		leases:     make(map[uint64]time.Time),
+		freed:      make(chan struct{}),
 	}
 }
 
+// Add registers processor, previous one with the same id is replaced
 func (ps *ProcessorStorage) Add(processor domain.ProcessorClientInfo) {
 	ps.mu.Lock()
 	defer ps.mu.Unlock()
 
-	ps.processors = append(ps.processors, processor)
+	ps.remove(processor.Processor.Id)
+	ps.lastSeq++
+	ps.processors = append(ps.processors, &processorState{
+		info:       processor,
+		seq:        ps.lastSeq,
+		lastChange: ps.clk.Now(),
+	})
+	ps.notifyFreed()
+}
+
+func (ps *ProcessorStorage) Remove(processorID uint64) (domain.ProcessorClientInfo, bool) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	return ps.remove(processorID)
 }
 
 func (ps *ProcessorStorage) Get() []domain.ProcessorClientInfo {
 	ps.mu.RLock()
 	defer ps.mu.RUnlock()
 
-	return slices.Clone(ps.processors)
+	processors := make([]domain.ProcessorClientInfo, 0, len(ps.processors))
+	for _, state := range ps.processors {
+		processors = append(processors, state.info)
+	}
+
+	return processors
+}
+
+func (ps *ProcessorStorage) Has(processorID uint64) bool {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	return ps.find(processorID) >= 0
 }
 
-func (ps *ProcessorStorage) IsBusy(processorID uint64) bool {
+// Renew prolongs processor lease, returns false for unknown processor
+func (ps *ProcessorStorage) Renew(processorID uint64, deadline time.Time) bool {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if ps.find(processorID) < 0 {
+		return false
+	}
+	ps.leases[processorID] = deadline
+
+	return true
+}
+
+// Expired returns processors which lease ended before now
+func (ps *ProcessorStorage) Expired(now time.Time) []domain.ProcessorClientInfo {
 	ps.mu.RLock()
 	defer ps.mu.RUnlock()
 
-	return ps.busyProcessors[processorID]
+	expired := make([]domain.ProcessorClientInfo, 0)
+	for _, state := range ps.processors {
+		deadline, ok := ps.leases[state.info.Processor.Id]
+		if ok && deadline.Before(now) {
+			expired = append(expired, state.info)
+		}
+	}
+
+	return expired
+}
+
+// Acquire takes slot of processor chosen by selection strategy at the moment
+// of dispatch, returns false if all slots are busy
+func (ps *ProcessorStorage) Acquire() (domain.ProcessorClientInfo, bool) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	return ps.acquire(ps.candidates())
 }
 
-func (ps *ProcessorStorage) SetBusy(processorID uint64) {
+// AcquireAvoiding is Acquire which gives slot of the avoided processor
+// only if no other processor is free
+func (ps *ProcessorStorage) AcquireAvoiding(processorID uint64) (domain.ProcessorClientInfo, bool) {
 	ps.mu.Lock()
 	defer ps.mu.Unlock()
 
-	ps.busyProcessors[processorID] = true
+	candidates := ps.candidates()
+	if others := slices.DeleteFunc(slices.Clone(candidates), func(state *processorState) bool {
+		return state.info.Processor.Id == processorID
+	}); len(others) > 0 {
+		candidates = others
+	}
+
+	return ps.acquire(candidates)
+}
+
+// InFlight returns number of incedents processor is busy with
+func (ps *ProcessorStorage) InFlight(processorID uint64) int {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	i := ps.find(processorID)
+	if i < 0 {
+		return 0
+	}
+
+	return ps.processors[i].inFlight
 }
 
+// SetFree releases one slot of the processor
 func (ps *ProcessorStorage) SetFree(processorID uint64) {
 	ps.mu.Lock()
 	defer ps.mu.Unlock()
 
-	ps.busyProcessors[processorID] = false
+	i := ps.find(processorID)
+	if i < 0 || ps.processors[i].inFlight == 0 {
+		return
+	}
+	state := ps.processors[i]
+	state.setInFlight(state.inFlight-1, ps.clk.Now())
+	ps.notifyFreed()
+}
+
+// Freed returns channel which is closed when slots may have become free,
+// it must be taken before Acquire so that no release is missed
+func (ps *ProcessorStorage) Freed() <-chan struct{} {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	return ps.freed
+}
+
+// SetSelectionStrategy replaces strategy, state of the previous one is lost
+func (ps *ProcessorStorage) SetSelectionStrategy(name string) error {
+	strategy, err := NewSelectionStrategy(name)
+	if err != nil {
+		return err
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	ps.strategy = strategy
+
+	return nil
+}
+
+// Statuses returns registered processors in registration order
+func (ps *ProcessorStorage) Statuses() []domain.ProcessorStatus {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	statuses := make([]domain.ProcessorStatus, 0, len(ps.processors))
+	for _, state := range ps.processors {
+		statuses = append(statuses, domain.ProcessorStatus{
+			Processor: state.info.Processor,
+			Busy:      state.inFlight,
+			Draining:  state.draining,
+		})
+	}
+
+	return statuses
+}
+
+// Drain stops giving processor slots away, returns false for unknown processor
+func (ps *ProcessorStorage) Drain(processorID uint64) bool {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	i := ps.find(processorID)
+	if i < 0 {
+		return false
+	}
+	ps.processors[i].draining = true
+
+	return true
+}
+
+// Drained returns draining processors which finished all their incedents
+func (ps *ProcessorStorage) Drained() []domain.ProcessorClientInfo {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	drained := make([]domain.ProcessorClientInfo, 0)
+	for _, state := range ps.processors {
+		if state.draining && state.inFlight == 0 {
+			drained = append(drained, state.info)
+		}
+	}
+
+	return drained
+}
+
+func (ps *ProcessorStorage) candidates() []*processorState {
+	candidates := make([]*processorState, 0, len(ps.processors))
+	for _, state := range ps.processors {
+		if !state.draining && state.hasFreeSlot() {
+			candidates = append(candidates, state)
+		}
+	}
+
+	return candidates
+}
+
+func (ps *ProcessorStorage) acquire(candidates []*processorState) (domain.ProcessorClientInfo, bool) {
+	if len(candidates) == 0 {
+		return domain.ProcessorClientInfo{}, false
+	}
+	chosen := candidates[ps.strategy.choose(candidates)]
+	chosen.setInFlight(chosen.inFlight+1, ps.clk.Now())
+
+	return chosen.info, true
+}
+
+func (ps *ProcessorStorage) notifyFreed() {
+	close(ps.freed)
+	ps.freed = make(chan struct{})
+}
+
+func (ps *ProcessorStorage) find(processorID uint64) int {
+	return slices.IndexFunc(ps.processors, func(state *processorState) bool {
+		return state.info.Processor.Id == processorID
+	})
+}
+
+func (ps *ProcessorStorage) remove(processorID uint64) (domain.ProcessorClientInfo, bool) {
+	i := ps.find(processorID)
+	if i < 0 {
+		return domain.ProcessorClientInfo{}, false
+	}
+	processor := ps.processors[i].info
+	ps.processors = slices.Delete(ps.processors, i, i+1)
+	delete(ps.leases, processorID)
+
+	return processor, true
 }
@@ -0,0 +1,285 @@
+package repositories
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+)
+
+const metricsNamespace = "dispatcher"
+
+var timeBuckets = prometheus.ExponentialBuckets(0.001, 2, 16)
+
+type startedIncedent struct {
+	processorID uint64
+	start       time.Time
+}
+
+type processorUsage struct {
+	capacity int
+	regTime  time.Time
+	busy     time.Duration
+}
+
+// PrometheusMetricsStorage exports live metrics fed by the MetricsStorage hooks,
+// statistics are still collected by the wrapped storage.
+type PrometheusMetricsStorage struct {
+	*MetricsStorage
+	clk clock.Clock
+
+	received        *prometheus.CounterVec
+	processed       *prometheus.CounterVec
+	rejected        *prometheus.CounterVec
+	evicted         *prometheus.CounterVec
+	bufferOccupancy *prometheus.GaugeVec
+	waitTime        *prometheus.HistogramVec
+	serviceTime     *prometheus.HistogramVec
+	busyRatio       *prometheus.Desc
+	configChanges   *prometheus.CounterVec
+
+	mu         sync.Mutex
+	buffered   map[incedentKey]struct{}
+	started    map[incedentKey]startedIncedent
+	processors map[uint64]*processorUsage
+}
+
+func NewPrometheusMetricsStorage(
+	storage *MetricsStorage,
+	clk clock.Clock,
+	registerer prometheus.Registerer,
+) *PrometheusMetricsStorage {
+	ps := &PrometheusMetricsStorage{
+		MetricsStorage: storage,
+		clk:            clk,
+		received: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "incedents_received_total",
+			Help:      "Number of incedents received from producers.",
+		}, []string{"priority"}),
+		processed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "incedents_processed_total",
+			Help:      "Number of successfully processed incedents.",
+		}, []string{"priority"}),
+		rejected: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "incedents_rejected_total",
+			Help:      "Number of incedents finished with error.",
+		}, []string{"priority"}),
+		evicted: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "incedents_evicted_total",
+			Help:      "Number of incedents evicted from the buffer or refused by eviction policy.",
+		}, []string{"priority"}),
+		bufferOccupancy: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "buffer_incedents",
+			Help:      "Number of incedents waiting in the buffer.",
+		}, []string{"priority"}),
+		waitTime: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "incedent_wait_seconds",
+			Help:      "Time incedent spent in the buffer before processing.",
+			Buckets:   timeBuckets,
+		}, []string{"priority"}),
+		serviceTime: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "incedent_service_seconds",
+			Help:      "Time processor spent on incedent.",
+			Buckets:   timeBuckets,
+		}, []string{"priority"}),
+		busyRatio: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "processor_busy_ratio"),
+			"Share of processor slots time spent on incedents since registration.",
+			[]string{"processor"}, nil,
+		),
+		configChanges: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "config_changes_total",
+			Help:      "Number of settings changed without restart.",
+		}, []string{"setting"}),
+		buffered:   make(map[incedentKey]struct{}),
+		started:    make(map[incedentKey]startedIncedent),
+		processors: make(map[uint64]*processorUsage),
+	}
+	registerer.MustRegister(
+		ps.received, ps.processed, ps.rejected, ps.evicted,
+		ps.bufferOccupancy, ps.waitTime, ps.serviceTime, ps.configChanges,
+		busyRatioCollector{ps},
+	)
+
+	return ps
+}
+
+func (ps *PrometheusMetricsStorage) RegisteredProcessor(processor domain.IncedentProcessor) {
+	ps.MetricsStorage.RegisteredProcessor(processor)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.processors[processor.Id] = &processorUsage{
+		capacity: int(max(processor.Capacity, 1)),
+		regTime:  ps.clk.Now(),
+	}
+}
+
+func (ps *PrometheusMetricsStorage) DeregisteredProcessor(processor domain.IncedentProcessor, expired bool) {
+	ps.MetricsStorage.DeregisteredProcessor(processor, expired)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	delete(ps.processors, processor.Id)
+}
+
+func (ps *PrometheusMetricsStorage) ConfigChanged(setting, value string) {
+	ps.MetricsStorage.ConfigChanged(setting, value)
+	ps.configChanges.WithLabelValues(setting).Inc()
+}
+
+func (ps *PrometheusMetricsStorage) ReceivedIncedent(incedent domain.Incedent) {
+	ps.MetricsStorage.ReceivedIncedent(incedent)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.received.WithLabelValues(priorityLabel(incedent.Priority)).Inc()
+	ps.buffered[keyOf(incedent)] = struct{}{}
+	ps.bufferOccupancy.WithLabelValues(priorityLabel(incedent.Priority)).Inc()
+}
+
+func (ps *PrometheusMetricsStorage) ProcessInedent(incedent domain.Incedent, processor domain.IncedentProcessor) {
+	ps.MetricsStorage.ProcessInedent(incedent, processor)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	now := ps.clk.Now()
+	if ps.leaveBuffer(incedent) {
+		ps.waitTime.WithLabelValues(priorityLabel(incedent.Priority)).
+			Observe(now.Sub(incedent.CreationTime).Seconds())
+	}
+	ps.started[keyOf(incedent)] = startedIncedent{processorID: processor.Id, start: now}
+}
+
+func (ps *PrometheusMetricsStorage) IncedentAttemptFailed(
+	incedent domain.Incedent,
+	processor domain.IncedentProcessor,
+	attempt int,
+) {
+	ps.MetricsStorage.IncedentAttemptFailed(incedent, processor, attempt)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.finishProcessing(incedent)
+}
+
+func (ps *PrometheusMetricsStorage) IncedentFailed(incedent domain.Incedent, processor domain.IncedentProcessor) {
+	ps.MetricsStorage.IncedentFailed(incedent, processor)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.finishProcessing(incedent)
+}
+
+func (ps *PrometheusMetricsStorage) IncedentProcessed(incedent domain.Incedent, processor domain.IncedentProcessor) {
+	ps.MetricsStorage.IncedentProcessed(incedent, processor)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if spent, ok := ps.finishProcessing(incedent); ok {
+		ps.serviceTime.WithLabelValues(priorityLabel(incedent.Priority)).Observe(spent.Seconds())
+	}
+	ps.processed.WithLabelValues(priorityLabel(incedent.Priority)).Inc()
+}
+
+func (ps *PrometheusMetricsStorage) IncedentEvicted(incedent domain.Incedent) {
+	ps.MetricsStorage.IncedentEvicted(incedent)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.leaveBuffer(incedent)
+	ps.evicted.WithLabelValues(priorityLabel(incedent.Priority)).Inc()
+}
+
+func (ps *PrometheusMetricsStorage) IncedentRejected(incedent domain.Incedent) {
+	ps.MetricsStorage.IncedentRejected(incedent)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.leaveBuffer(incedent)
+	ps.finishProcessing(incedent)
+	ps.rejected.WithLabelValues(priorityLabel(incedent.Priority)).Inc()
+}
+
+// leaveBuffer reports whether incedent was in the buffer
+func (ps *PrometheusMetricsStorage) leaveBuffer(incedent domain.Incedent) bool {
+	key := keyOf(incedent)
+	if _, ok := ps.buffered[key]; !ok {
+		return false
+	}
+	delete(ps.buffered, key)
+	ps.bufferOccupancy.WithLabelValues(priorityLabel(incedent.Priority)).Dec()
+
+	return true
+}
+
+// finishProcessing accounts processor busy time, returns time spent on incedent
+func (ps *PrometheusMetricsStorage) finishProcessing(incedent domain.Incedent) (time.Duration, bool) {
+	key := keyOf(incedent)
+	started, ok := ps.started[key]
+	if !ok {
+		return 0, false
+	}
+	delete(ps.started, key)
+
+	spent := ps.clk.Since(started.start)
+	if usage, ok := ps.processors[started.processorID]; ok {
+		usage.busy += spent
+	}
+
+	return spent, true
+}
+
+// busyRatioCollector computes busy ratio of processors on scrape,
+// so idle processors aren't left with the ratio of their last incedent
+type busyRatioCollector struct {
+	ps *PrometheusMetricsStorage
+}
+
+func (c busyRatioCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.ps.busyRatio
+}
+
+func (c busyRatioCollector) Collect(ch chan<- prometheus.Metric) {
+	c.ps.mu.Lock()
+	defer c.ps.mu.Unlock()
+
+	now := c.ps.clk.Now()
+	busy := make(map[uint64]time.Duration, len(c.ps.processors))
+	for id, usage := range c.ps.processors {
+		busy[id] = usage.busy
+	}
+	// incedents in processing are counted up to now
+	for _, started := range c.ps.started {
+		if _, ok := busy[started.processorID]; ok {
+			busy[started.processorID] += now.Sub(started.start)
+		}
+	}
+	for id, usage := range c.ps.processors {
+		var ratio float64
+		if slotsOn := now.Sub(usage.regTime) * time.Duration(usage.capacity); slotsOn > 0 {
+			ratio = busy[id].Seconds() / slotsOn.Seconds()
+		}
+		ch <- prometheus.MustNewConstMetric(c.ps.busyRatio, prometheus.GaugeValue, ratio, processorLabel(id))
+	}
+}
+
+func priorityLabel(priority domain.Priority) string {
+	return strconv.FormatUint(uint64(priority), 10)
+}
+
+func processorLabel(processorID uint64) string {
+	return strconv.FormatUint(processorID, 10)
+}
@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+var _ = Describe("PrometheusMetricsStorage", func() {
+	var (
+		clk       *clock.Mock
+		storage   *PrometheusMetricsStorage
+		processor = domain.IncedentProcessor{Id: 7, Capacity: 2}
+	)
+
+	BeforeEach(func() {
+		clk = clock.NewMock()
+		storage = NewPrometheusMetricsStorage(
+			NewMetricsStorage(logger.InitZapWrapper(zap.NewNop()), clk, domain.Precision{}, domain.WarmUp{}),
+			clk,
+			prometheus.NewRegistry(),
+		)
+		storage.RegisteredProcessor(processor)
+	})
+
+	It("Tracks buffer occupancy and counters", func() {
+		first := domain.Incedent{Id: 1, Priority: 2, CreationTime: clk.Now()}
+		second := domain.Incedent{Id: 2, Priority: 2, CreationTime: clk.Now()}
+		storage.ReceivedIncedent(first)
+		storage.ReceivedIncedent(second)
+		Expect(testutil.ToFloat64(storage.bufferOccupancy.WithLabelValues("2"))).To(Equal(2.0))
+
+		storage.IncedentEvicted(second)
+		storage.IncedentRejected(second)
+		storage.ProcessInedent(first, processor)
+		Expect(testutil.ToFloat64(storage.bufferOccupancy.WithLabelValues("2"))).To(Equal(0.0))
+
+		clk.Add(time.Second)
+		storage.IncedentProcessed(first, processor)
+		Expect(testutil.ToFloat64(storage.received.WithLabelValues("2"))).To(Equal(2.0))
+		Expect(testutil.ToFloat64(storage.processed.WithLabelValues("2"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(storage.rejected.WithLabelValues("2"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(storage.evicted.WithLabelValues("2"))).To(Equal(1.0))
+		// one of two slots was busy for the whole second
+		Expect(testutil.ToFloat64(busyRatioCollector{storage})).To(Equal(0.5))
+	})
+
+	It("Busy ratio is computed on scrape", func() {
+		first := domain.Incedent{Id: 1, Priority: 1, CreationTime: clk.Now()}
+		storage.ReceivedIncedent(first)
+		storage.ProcessInedent(first, processor)
+		clk.Add(time.Second)
+		// incedent in processing is counted
+		Expect(testutil.ToFloat64(busyRatioCollector{storage})).To(Equal(0.5))
+
+		storage.IncedentProcessed(first, processor)
+		clk.Add(time.Second)
+		// idle processor ratio goes down
+		Expect(testutil.ToFloat64(busyRatioCollector{storage})).To(Equal(0.25))
+
+		storage.DeregisteredProcessor(processor, false)
+		Expect(testutil.CollectAndCount(busyRatioCollector{storage})).To(BeZero())
+	})
+})
@@ -0,0 +1,299 @@
+package repositories
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+)
+
+type timeStatsJSON struct {
+	Mean     float64 `json:"mean_sec"`
+	Variance float64 `json:"variance_sec2"`
+}
+
+type intervalJSON struct {
+	Mean      float64 `json:"mean"`
+	HalfWidth float64 `json:"half_width"`
+	Samples   int     `json:"samples"`
+}
+
+type precisionJSON struct {
+	Confidence float64 `json:"confidence"`
+	Accuracy   float64 `json:"accuracy"`
+}
+
+type priorityReportJSON struct {
+	Priority         uint64        `json:"priority"`
+	Total            int           `json:"total"`
+	Processed        int           `json:"processed"`
+	Rejected         int           `json:"rejected"`
+	Evicted          int           `json:"evicted"`
+	Retried          int           `json:"retried"`
+	InProgress       int           `json:"in_progress"`
+	PRejected        float64       `json:"p_rejected"`
+	TimeInSystem     float64       `json:"time_in_system_sec"`
+	TimeInBuffer     timeStatsJSON `json:"time_in_buffer"`
+	TimeInProcessing timeStatsJSON `json:"time_in_processing"`
+	// intervals of time in system are in seconds
+	PRejectedInterval    intervalJSON `json:"p_rejected_interval"`
+	TimeInSystemInterval intervalJSON `json:"time_in_system_interval"`
+	RequiredIncedents    int          `json:"required_incedents"`
+}
+
+type configChangeJSON struct {
+	Time    time.Time `json:"time"`
+	Setting string    `json:"setting"`
+	Value   string    `json:"value"`
+}
+
+type processorReportJSON struct {
+	Id             uint64    `json:"id"`
+	Capacity       int       `json:"capacity"`
+	RegTime        time.Time `json:"registered_at"`
+	EndTime        time.Time `json:"end_at"`
+	Deregistered   bool      `json:"deregistered"`
+	LeaseExpired   bool      `json:"lease_expired"`
+	Uptime         float64   `json:"uptime_sec"`
+	InWork         float64   `json:"in_work_sec"`
+	FailedAttempts int       `json:"failed_attempts"`
+	Utilization    float64   `json:"utilization"`
+}
+
+type reportJSON struct {
+	Time       time.Time             `json:"time"`
+	Since      time.Time             `json:"since"`
+	Precision  precisionJSON         `json:"precision"`
+	Priorities []priorityReportJSON  `json:"priorities"`
+	Processors []processorReportJSON `json:"processors"`
+	// ConfigChanges are omitted if nothing was changed
+	ConfigChanges []configChangeJSON `json:"config_changes,omitempty"`
+}
+
+var (
+	priorityCSVHeader = []string{
+		"priority", "total", "processed", "rejected", "evicted", "retried", "in_progress",
+		"p_rejected", "time_in_system_sec",
+		"time_in_buffer_mean_sec", "time_in_buffer_variance_sec2",
+		"time_in_processing_mean_sec", "time_in_processing_variance_sec2",
+		"p_rejected_half_width", "time_in_system_half_width_sec", "required_incedents",
+	}
+	processorCSVHeader = []string{
+		"id", "capacity", "registered_at", "end_at", "deregistered", "lease_expired",
+		"uptime_sec", "in_work_sec", "failed_attempts", "utilization",
+	}
+)
+
+// ReportWriter writes reports to <path>.json, <path>-priorities.csv and <path>-processors.csv
+type ReportWriter struct {
+	path string
+}
+
+func NewReportWriter(path string) *ReportWriter {
+	return &ReportWriter{
+		path: path,
+	}
+}
+
+func (rw *ReportWriter) Write(report domain.Report) error {
+	if err := os.MkdirAll(filepath.Dir(rw.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create report directory: %w", err)
+	}
+	if err := rw.writeJSON(report); err != nil {
+		return err
+	}
+	if err := rw.writeCSV(rw.path+"-priorities.csv", priorityCSVHeader, priorityRows(report)); err != nil {
+		return err
+	}
+
+	return rw.writeCSV(rw.path+"-processors.csv", processorCSVHeader, processorRows(report))
+}
+
+func (rw *ReportWriter) writeJSON(report domain.Report) error {
+	out := reportJSON{
+		Time:       report.Time,
+		Since:      report.Since,
+		Precision:  precisionJSON(report.Precision),
+		Priorities: make([]priorityReportJSON, 0, len(report.Priorities)),
+		Processors: make([]processorReportJSON, 0, len(report.Processors)),
+	}
+	for _, p := range report.Priorities {
+		out.Priorities = append(out.Priorities, priorityReportJSON{
+			Priority:             uint64(p.Priority),
+			Total:                p.Total,
+			Processed:            p.Processed,
+			Rejected:             p.Rejected,
+			Evicted:              p.Evicted,
+			Retried:              p.Retried,
+			InProgress:           p.InProgress,
+			PRejected:            p.PRejected,
+			TimeInSystem:         p.TimeInSystem.Seconds(),
+			TimeInBuffer:         timeStatsJSON{Mean: p.TimeInBuffer.Mean.Seconds(), Variance: p.TimeInBuffer.Variance},
+			TimeInProcessing:     timeStatsJSON{Mean: p.TimeInProcessing.Mean.Seconds(), Variance: p.TimeInProcessing.Variance},
+			PRejectedInterval:    intervalJSON(p.PRejectedInterval),
+			TimeInSystemInterval: intervalJSON(p.TimeInSystemInterval),
+			RequiredIncedents:    p.RequiredIncedents,
+		})
+	}
+	for _, p := range report.Processors {
+		out.Processors = append(out.Processors, processorReportJSON{
+			Id:             p.Id,
+			Capacity:       p.Capacity,
+			RegTime:        p.RegTime,
+			EndTime:        p.EndTime,
+			Deregistered:   p.Deregistered,
+			LeaseExpired:   p.LeaseExpired,
+			Uptime:         p.Uptime.Seconds(),
+			InWork:         p.InWork.Seconds(),
+			FailedAttempts: p.FailedAttempts,
+			Utilization:    p.Utilization,
+		})
+	}
+	for _, change := range report.ConfigChanges {
+		out.ConfigChanges = append(out.ConfigChanges, configChangeJSON(change))
+	}
+
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal report: %w", err)
+	}
+	if err := os.WriteFile(rw.path+".json", data, 0o644); err != nil {
+		return fmt.Errorf("failed to write json report: %w", err)
+	}
+
+	return nil
+}
+
+// ReadReport reads json report written by ReportWriter
+func ReadReport(path string) (domain.Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return domain.Report{}, fmt.Errorf("failed to read json report: %w", err)
+	}
+	var in reportJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return domain.Report{}, fmt.Errorf("failed to unmarshal report: %w", err)
+	}
+
+	report := domain.Report{
+		Time:       in.Time,
+		Since:      in.Since,
+		Precision:  domain.Precision(in.Precision),
+		Priorities: make([]domain.PriorityReport, 0, len(in.Priorities)),
+		Processors: make([]domain.ProcessorReport, 0, len(in.Processors)),
+	}
+	for _, p := range in.Priorities {
+		report.Priorities = append(report.Priorities, domain.PriorityReport{
+			Priority:             domain.Priority(p.Priority),
+			Total:                p.Total,
+			Processed:            p.Processed,
+			Rejected:             p.Rejected,
+			Evicted:              p.Evicted,
+			Retried:              p.Retried,
+			InProgress:           p.InProgress,
+			PRejected:            p.PRejected,
+			TimeInSystem:         seconds(p.TimeInSystem),
+			TimeInBuffer:         domain.TimeStats{Mean: seconds(p.TimeInBuffer.Mean), Variance: p.TimeInBuffer.Variance},
+			TimeInProcessing:     domain.TimeStats{Mean: seconds(p.TimeInProcessing.Mean), Variance: p.TimeInProcessing.Variance},
+			PRejectedInterval:    domain.ConfidenceInterval(p.PRejectedInterval),
+			TimeInSystemInterval: domain.ConfidenceInterval(p.TimeInSystemInterval),
+			RequiredIncedents:    p.RequiredIncedents,
+		})
+	}
+	for _, p := range in.Processors {
+		report.Processors = append(report.Processors, domain.ProcessorReport{
+			Id:             p.Id,
+			Capacity:       p.Capacity,
+			RegTime:        p.RegTime,
+			EndTime:        p.EndTime,
+			Deregistered:   p.Deregistered,
+			LeaseExpired:   p.LeaseExpired,
+			Uptime:         seconds(p.Uptime),
+			InWork:         seconds(p.InWork),
+			FailedAttempts: p.FailedAttempts,
+			Utilization:    p.Utilization,
+		})
+	}
+	for _, change := range in.ConfigChanges {
+		report.ConfigChanges = append(report.ConfigChanges, domain.ConfigChange(change))
+	}
+
+	return report, nil
+}
+
+func (rw *ReportWriter) writeCSV(path string, header []string, rows [][]string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create csv report: %w", err)
+	}
+	defer file.Close()
+
+	w := csv.NewWriter(file)
+	if err := w.Write(header); err != nil {
+		return fmt.Errorf("failed to write csv report: %w", err)
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return fmt.Errorf("failed to write csv report: %w", err)
+	}
+
+	return file.Close()
+}
+
+func priorityRows(report domain.Report) [][]string {
+	rows := make([][]string, 0, len(report.Priorities))
+	for _, p := range report.Priorities {
+		rows = append(rows, []string{
+			strconv.FormatUint(uint64(p.Priority), 10),
+			strconv.Itoa(p.Total),
+			strconv.Itoa(p.Processed),
+			strconv.Itoa(p.Rejected),
+			strconv.Itoa(p.Evicted),
+			strconv.Itoa(p.Retried),
+			strconv.Itoa(p.InProgress),
+			formatFloat(p.PRejected),
+			formatFloat(p.TimeInSystem.Seconds()),
+			formatFloat(p.TimeInBuffer.Mean.Seconds()),
+			formatFloat(p.TimeInBuffer.Variance),
+			formatFloat(p.TimeInProcessing.Mean.Seconds()),
+			formatFloat(p.TimeInProcessing.Variance),
+			formatFloat(p.PRejectedInterval.HalfWidth),
+			formatFloat(p.TimeInSystemInterval.HalfWidth),
+			strconv.Itoa(p.RequiredIncedents),
+		})
+	}
+
+	return rows
+}
+
+func processorRows(report domain.Report) [][]string {
+	rows := make([][]string, 0, len(report.Processors))
+	for _, p := range report.Processors {
+		rows = append(rows, []string{
+			strconv.FormatUint(p.Id, 10),
+			strconv.Itoa(p.Capacity),
+			p.RegTime.Format(time.RFC3339Nano),
+			p.EndTime.Format(time.RFC3339Nano),
+			strconv.FormatBool(p.Deregistered),
+			strconv.FormatBool(p.LeaseExpired),
+			formatFloat(p.Uptime.Seconds()),
+			formatFloat(p.InWork.Seconds()),
+			strconv.Itoa(p.FailedAttempts),
+			formatFloat(p.Utilization),
+		})
+	}
+
+	return rows
+}
+
+func seconds(value float64) time.Duration {
+	return time.Duration(value * float64(time.Second))
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
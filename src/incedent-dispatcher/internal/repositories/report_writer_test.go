@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+var _ = Describe("Report", func() {
+	var (
+		clk       *clock.Mock
+		storage   *MetricsStorage
+		processor = domain.IncedentProcessor{Id: 1}
+	)
+
+	// process puts incedent through the storage, it waits in buffer and is processed for given times
+	process := func(id uint64, wait, work time.Duration) {
+		incedent := domain.Incedent{Id: id, Priority: 1, CreationTime: clk.Now()}
+		storage.ReceivedIncedent(incedent)
+		clk.Add(wait)
+		storage.ProcessInedent(incedent, processor)
+		clk.Add(work)
+		storage.IncedentProcessed(incedent, processor)
+	}
+
+	BeforeEach(func() {
+		clk = clock.NewMock()
+		storage = NewMetricsStorage(logger.InitZapWrapper(zap.NewNop()), clk, domain.Precision{Confidence: 0.95}, domain.WarmUp{})
+		storage.RegisteredProcessor(processor)
+		process(1, 0, time.Second)
+		process(2, 2*time.Second, 3*time.Second)
+		rejected := domain.Incedent{Id: 3, Priority: 1, CreationTime: clk.Now()}
+		storage.ReceivedIncedent(rejected)
+		storage.IncedentRejected(rejected)
+		clk.Add(2 * time.Second)
+	})
+
+	It("Computes mean and variance", func() {
+		report := storage.Report()
+		Expect(report.Priorities).To(HaveLen(1))
+		stats := report.Priorities[0]
+		Expect(stats.Total).To(Equal(3))
+		Expect(stats.Rejected).To(Equal(1))
+		Expect(stats.PRejected).To(BeNumerically("~", 1.0/3))
+		Expect(stats.TimeInProcessing).To(Equal(domain.TimeStats{Mean: 2 * time.Second, Variance: 2}))
+		Expect(stats.TimeInBuffer).To(Equal(domain.TimeStats{Mean: time.Second, Variance: 2}))
+
+		Expect(report.Processors).To(HaveLen(1))
+		Expect(report.Processors[0].InWork).To(Equal(4 * time.Second))
+		Expect(report.Processors[0].Utilization).To(BeNumerically("~", 0.5))
+	})
+
+	It("Repeated reports are the same", func() {
+		Expect(storage.Report()).To(Equal(storage.Report()))
+	})
+
+	It("Writes json and csv", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "out", "report")
+		Expect(NewReportWriter(path).Write(storage.Report())).To(Succeed())
+
+		data, err := os.ReadFile(path + ".json")
+		Expect(err).To(Succeed())
+		var decoded reportJSON
+		Expect(json.Unmarshal(data, &decoded)).To(Succeed())
+		Expect(decoded.Priorities[0].TimeInProcessing.Mean).To(Equal(2.0))
+
+		file, err := os.Open(path + "-processors.csv")
+		Expect(err).To(Succeed())
+		defer file.Close()
+		rows, err := csv.NewReader(file).ReadAll()
+		Expect(err).To(Succeed())
+		Expect(rows).To(HaveLen(2))
+		Expect(rows[0]).To(Equal(processorCSVHeader))
+		Expect(rows[1][len(rows[1])-1]).To(Equal("0.5"))
+	})
+
+	It("Reads written report back", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "report")
+		report := storage.Report()
+		Expect(NewReportWriter(path).Write(report)).To(Succeed())
+
+		read, err := ReadReport(path + ".json")
+		Expect(err).To(Succeed())
+		Expect(read.Priorities).To(Equal(report.Priorities))
+		Expect(read.Processors[0].InWork).To(Equal(report.Processors[0].InWork))
+	})
+})
@@ -0,0 +1,152 @@
+package repositories
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+)
+
+const (
+	RoundRobinStrategy        = "round-robin"
+	LeastRecentlyUsedStrategy = "least-recently-used"
+	RandomStrategy            = "random"
+	LeastWorkStrategy         = "least-cumulative-work"
+	WeightedStrategy          = "weighted"
+)
+
+// processorState is what selection strategies know about processor with free slots,
+// candidates are given in registration order.
+type processorState struct {
+	info     domain.ProcessorClientInfo
+	seq      uint64
+	inFlight int
+	lastUsed time.Time
+	// time of the last inFlight change
+	lastChange time.Time
+	// work is summed over all slots
+	work time.Duration
+	// draining processor gets no new incedents
+	draining bool
+}
+
+func (s *processorState) capacity() int {
+	return int(max(s.info.Processor.Capacity, 1))
+}
+
+func (s *processorState) hasFreeSlot() bool {
+	return s.inFlight < s.capacity()
+}
+
+// setInFlight accounts work done by busy slots since the last change
+func (s *processorState) setInFlight(inFlight int, now time.Time) {
+	s.work += time.Duration(s.inFlight) * now.Sub(s.lastChange)
+	s.inFlight = inFlight
+	s.lastChange = now
+	s.lastUsed = now
+}
+
+// selectionStrategy decides which free processor gets the next incedent,
+// it's called under the processors storage lock.
+type selectionStrategy interface {
+	// choose returns index of chosen candidate, candidates are never empty
+	choose(candidates []*processorState) int
+}
+
+func NewSelectionStrategy(name string) (selectionStrategy, error) {
+	switch name {
+	case "", RoundRobinStrategy:
+		return &roundRobinStrategy{}, nil
+	case LeastRecentlyUsedStrategy:
+		return leastRecentlyUsedStrategy{}, nil
+	case RandomStrategy:
+		return randomStrategy{}, nil
+	case LeastWorkStrategy:
+		return leastWorkStrategy{}, nil
+	case WeightedStrategy:
+		return &weightedStrategy{current: make(map[uint64]int64)}, nil
+	default:
+		return nil, fmt.Errorf("unknown selection strategy '%s'", name)
+	}
+}
+
+// roundRobinStrategy chooses the first free processor registered after the previous choice.
+type roundRobinStrategy struct {
+	lastSeq uint64
+}
+
+func (rr *roundRobinStrategy) choose(candidates []*processorState) int {
+	chosen := 0
+	for i, candidate := range candidates {
+		if candidate.seq > rr.lastSeq {
+			chosen = i
+			break
+		}
+	}
+	rr.lastSeq = candidates[chosen].seq
+
+	return chosen
+}
+
+// leastRecentlyUsedStrategy chooses processor which was idle for the longest time.
+type leastRecentlyUsedStrategy struct{}
+
+func (leastRecentlyUsedStrategy) choose(candidates []*processorState) int {
+	chosen := 0
+	for i, candidate := range candidates {
+		if candidate.lastUsed.Before(candidates[chosen].lastUsed) {
+			chosen = i
+		}
+	}
+
+	return chosen
+}
+
+type randomStrategy struct{}
+
+func (randomStrategy) choose(candidates []*processorState) int {
+	return rand.IntN(len(candidates))
+}
+
+// leastWorkStrategy chooses processor which spent the least time processing incedents
+// per slot.
+type leastWorkStrategy struct{}
+
+func (leastWorkStrategy) choose(candidates []*processorState) int {
+	chosen := 0
+	for i, candidate := range candidates {
+		if perSlotWork(candidate) < perSlotWork(candidates[chosen]) {
+			chosen = i
+		}
+	}
+
+	return chosen
+}
+
+func perSlotWork(state *processorState) time.Duration {
+	return state.work / time.Duration(state.capacity())
+}
+
+// weightedStrategy is smooth weighted round-robin, processors get incedents
+// proportionally to their weights without bursts.
+type weightedStrategy struct {
+	current map[uint64]int64
+}
+
+func (ws *weightedStrategy) choose(candidates []*processorState) int {
+	var total int64
+	chosen := 0
+	for i, candidate := range candidates {
+		id := candidate.info.Processor.Id
+		weight := int64(max(candidate.info.Processor.Weight, 1))
+		total += weight
+		ws.current[id] += weight
+		if ws.current[id] > ws.current[candidates[chosen].info.Processor.Id] {
+			chosen = i
+		}
+	}
+	ws.current[candidates[chosen].info.Processor.Id] -= total
+
+	return chosen
+}
@@ -0,0 +1,175 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+)
+
+var _ = Describe("SelectionStrategy", func() {
+	var clk *clock.Mock
+
+	newStorage := func(name string, weights ...uint32) *ProcessorStorage {
+		strategy, err := NewSelectionStrategy(name)
+		Expect(err).To(Succeed())
+		clk = clock.NewMock()
+		ps := NewProcessorStorage(clk, strategy)
+		for i, weight := range weights {
+			ps.Add(domain.ProcessorClientInfo{
+				Processor: domain.IncedentProcessor{Id: uint64(i), Weight: weight},
+			})
+		}
+
+		return ps
+	}
+
+	// dispatch acquires processors one by one, each of them works for given time
+	dispatch := func(ps *ProcessorStorage, n int, work func(id uint64) time.Duration) []uint64 {
+		chosen := make([]uint64, 0, n)
+		for range n {
+			processor, ok := ps.Acquire()
+			Expect(ok).To(BeTrue())
+			clk.Add(work(processor.Processor.Id))
+			ps.SetFree(processor.Processor.Id)
+			chosen = append(chosen, processor.Processor.Id)
+		}
+
+		return chosen
+	}
+
+	sameWork := func(uint64) time.Duration { return time.Second }
+
+	It("Unknown strategy", func() {
+		_, err := NewSelectionStrategy("unknown")
+		Expect(err).NotTo(Succeed())
+	})
+
+	It("Round-robin spreads incedents evenly", func() {
+		ps := newStorage(RoundRobinStrategy, 1, 1, 1)
+		Expect(dispatch(ps, 6, sameWork)).To(Equal([]uint64{0, 1, 2, 0, 1, 2}))
+	})
+
+	It("Least recently used chooses idle processor", func() {
+		ps := newStorage(LeastRecentlyUsedStrategy, 1, 1, 1)
+		Expect(dispatch(ps, 4, sameWork)).To(Equal([]uint64{0, 1, 2, 0}))
+	})
+
+	It("Least cumulative work avoids loaded processor", func() {
+		ps := newStorage(LeastWorkStrategy, 1, 1)
+		work := func(id uint64) time.Duration {
+			if id == 0 {
+				return 3 * time.Second
+			}
+			return time.Second
+		}
+		Expect(dispatch(ps, 5, work)).To(Equal([]uint64{0, 1, 1, 1, 0}))
+	})
+
+	It("Weighted follows processor weights", func() {
+		ps := newStorage(WeightedStrategy, 3, 1)
+		counts := map[uint64]int{}
+		for _, id := range dispatch(ps, 8, sameWork) {
+			counts[id]++
+		}
+		Expect(counts).To(Equal(map[uint64]int{0: 6, 1: 2}))
+	})
+
+	It("Busy processors are skipped", func() {
+		ps := newStorage(RoundRobinStrategy, 1, 1)
+		first, ok := ps.Acquire()
+		Expect(ok).To(BeTrue())
+		second, ok := ps.Acquire()
+		Expect(ok).To(BeTrue())
+		Expect(second.Processor.Id).NotTo(Equal(first.Processor.Id))
+		_, ok = ps.Acquire()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Multi-slot processor takes incedents up to its capacity", func() {
+		strategy, err := NewSelectionStrategy(RoundRobinStrategy)
+		Expect(err).To(Succeed())
+		ps := NewProcessorStorage(clock.NewMock(), strategy)
+		ps.Add(domain.ProcessorClientInfo{
+			Processor: domain.IncedentProcessor{Id: 1, Capacity: 3},
+		})
+		for range 3 {
+			_, ok := ps.Acquire()
+			Expect(ok).To(BeTrue())
+		}
+		_, ok := ps.Acquire()
+		Expect(ok).To(BeFalse())
+		Expect(ps.InFlight(1)).To(Equal(3))
+
+		ps.SetFree(1)
+		Expect(ps.InFlight(1)).To(Equal(2))
+		_, ok = ps.Acquire()
+		Expect(ok).To(BeTrue())
+	})
+
+	It("Draining processor gets no incedents", func() {
+		ps := newStorage(RoundRobinStrategy, 1, 1)
+		busy, ok := ps.Acquire()
+		Expect(ok).To(BeTrue())
+		idle := ps.Get()[1]
+
+		Expect(ps.Drain(busy.Processor.Id)).To(BeTrue())
+		Expect(ps.Drain(idle.Processor.Id)).To(BeTrue())
+		Expect(ps.Drain(42)).To(BeFalse())
+		Expect(ps.Drained()).To(ConsistOf(idle))
+		_, ok = ps.Acquire()
+		Expect(ok).To(BeFalse())
+
+		ps.SetFree(busy.Processor.Id)
+		Expect(ps.Drained()).To(ConsistOf(busy, idle))
+	})
+
+	It("Notifies about freed slots", func() {
+		ps := newStorage(RoundRobinStrategy, 1)
+		processor, ok := ps.Acquire()
+		Expect(ok).To(BeTrue())
+		freed := ps.Freed()
+		Expect(freed).NotTo(BeClosed())
+
+		ps.SetFree(processor.Processor.Id)
+		Expect(freed).To(BeClosed())
+		freed = ps.Freed()
+		Expect(freed).NotTo(BeClosed())
+		ps.Add(domain.ProcessorClientInfo{Processor: domain.IncedentProcessor{Id: 5}})
+		Expect(freed).To(BeClosed())
+	})
+
+	It("Leases expire after deadline", func() {
+		ps := newStorage(RoundRobinStrategy, 1, 1)
+		Expect(ps.Renew(42, clk.Now().Add(time.Second))).To(BeFalse())
+		Expect(ps.Renew(0, clk.Now().Add(time.Second))).To(BeTrue())
+		Expect(ps.Renew(1, clk.Now().Add(2*time.Second))).To(BeTrue())
+
+		Expect(ps.Expired(clk.Now().Add(time.Second))).To(BeEmpty())
+		expired := ps.Expired(clk.Now().Add(time.Second + time.Nanosecond))
+		Expect(expired).To(HaveLen(1))
+		Expect(expired[0].Processor.Id).To(BeZero())
+
+		// renewal moves the deadline
+		Expect(ps.Renew(0, clk.Now().Add(3*time.Second))).To(BeTrue())
+		expired = ps.Expired(clk.Now().Add(2*time.Second + time.Nanosecond))
+		Expect(expired).To(HaveLen(1))
+		Expect(expired[0].Processor.Id).To(Equal(uint64(1)))
+
+		// removed processor has no lease
+		_, ok := ps.Remove(1)
+		Expect(ok).To(BeTrue())
+		Expect(ps.Expired(clk.Now().Add(time.Hour))).To(HaveLen(1))
+		Expect(ps.Renew(1, clk.Now().Add(time.Hour))).To(BeFalse())
+	})
+
+	It("Strategy is replaced at runtime", func() {
+		ps := newStorage(RoundRobinStrategy, 1, 3)
+		Expect(ps.SetSelectionStrategy("unknown")).NotTo(Succeed())
+		Expect(ps.SetSelectionStrategy(WeightedStrategy)).To(Succeed())
+		Expect(dispatch(ps, 4, sameWork)).To(ConsistOf(uint64(0), uint64(1), uint64(1), uint64(1)))
+	})
+})
@@ -0,0 +1,179 @@
+package repositories
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+	"go.uber.org/zap"
+)
+
+// sliceBufferStorage keeps incedents in plain slices per priority,
+// it is a reference implementation for BufferStorage benchmarks.
+type sliceBufferStorage struct {
+	log         *logger.Logger
+	mu          sync.Mutex
+	maxCapacity int
+	currentSize int
+	buffer      map[domain.Priority][]domain.Incedent
+	policy      evictionPolicy
+}
+
+func newSliceBufferStorage(log *logger.Logger, bufferCapacity uint64, policy evictionPolicy) *sliceBufferStorage {
+	return &sliceBufferStorage{
+		log:         log,
+		maxCapacity: int(bufferCapacity),
+		buffer:      make(map[domain.Priority][]domain.Incedent),
+		policy:      policy,
+	}
+}
+
+func (bs *sliceBufferStorage) CheckAndPut(incedent domain.Incedent) error {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	if bs.currentSize >= bs.maxCapacity {
+		return errBufferFull
+	}
+	if !bs.policy.admit(bs, incedent) {
+		return errEarlyDropped
+	}
+	bs.putIncedent(incedent)
+
+	return nil
+}
+
+func (bs *sliceBufferStorage) EvictAndPut(incedent domain.Incedent) domain.Incedent {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	evicted := bs.policy.victim(bs, incedent)
+	if keyOf(evicted) == keyOf(incedent) {
+		return incedent
+	}
+	if err := bs.deleteIncedent(evicted); err != nil {
+		bs.log.Error("Eviction policy chose incedent out of buffer", zap.Stringer("incedent", evicted))
+		return incedent
+	}
+	bs.putIncedent(incedent)
+
+	return evicted
+}
+
+func (bs *sliceBufferStorage) GetPacket() []domain.Incedent {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	var maxPriority domain.Priority
+	for priority := range bs.buffer {
+		if priority > maxPriority {
+			maxPriority = priority
+		}
+	}
+
+	return bs.ejectPacket(maxPriority)
+}
+
+func (bs *sliceBufferStorage) DeleteIncedent(incedent domain.Incedent) error {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	err := bs.deleteIncedent(incedent)
+	if err != nil {
+		bs.currentSize--
+	}
+
+	return nil
+}
+
+func (bs *sliceBufferStorage) size() int {
+	return bs.currentSize
+}
+
+func (bs *sliceBufferStorage) capacity() int {
+	return bs.maxCapacity
+}
+
+func (bs *sliceBufferStorage) priorities() []domain.Priority {
+	priorities := make([]domain.Priority, 0, len(bs.buffer))
+	for priority, packet := range bs.buffer {
+		if len(packet) > 0 {
+			priorities = append(priorities, priority)
+		}
+	}
+	slices.Sort(priorities)
+
+	return priorities
+}
+
+func (bs *sliceBufferStorage) oldest(priority domain.Priority) (domain.Incedent, bool) {
+	packet := bs.buffer[priority]
+	if len(packet) == 0 {
+		return domain.Incedent{}, false
+	}
+
+	return slices.MinFunc(packet, func(a, b domain.Incedent) int {
+		return a.CreationTime.Compare(b.CreationTime)
+	}), true
+}
+
+func (bs *sliceBufferStorage) newest(priority domain.Priority) (domain.Incedent, bool) {
+	packet := bs.buffer[priority]
+	if len(packet) == 0 {
+		return domain.Incedent{}, false
+	}
+
+	return slices.MaxFunc(packet, func(a, b domain.Incedent) int {
+		return a.CreationTime.Compare(b.CreationTime)
+	}), true
+}
+
+func (bs *sliceBufferStorage) putIncedent(incedent domain.Incedent) {
+	// check slice exists
+	_ = bs.getPacket(incedent.Priority)
+
+	bs.buffer[incedent.Priority] = append(bs.buffer[incedent.Priority], incedent)
+	bs.currentSize++
+}
+
+func (bs *sliceBufferStorage) getPacket(priority domain.Priority) []domain.Incedent {
+	packet, ok := bs.buffer[priority]
+	if !ok {
+		packet = make([]domain.Incedent, 0, bs.maxCapacity)
+		bs.buffer[priority] = packet
+	}
+
+	return packet
+}
+
+func (bs *sliceBufferStorage) ejectPacket(priority domain.Priority) []domain.Incedent {
+	oldPacket := bs.getPacket(priority)
+	bs.buffer[priority] = make([]domain.Incedent, 0, bs.maxCapacity)
+
+	return oldPacket
+}
+
+func (bs *sliceBufferStorage) deleteIncedent(incedent domain.Incedent) error {
+	packet := bs.getPacket(incedent.Priority)
+	for i, curr := range packet {
+		if curr.Id == incedent.Id {
+			packet = sliceDelete(packet, i)
+			bs.buffer[incedent.Priority] = packet
+			bs.currentSize--
+
+			return nil
+		}
+	}
+
+	return errElementNotFound
+}
+
+func sliceDelete[T any](slice []T, index int) []T {
+	if index >= len(slice) {
+		panic(fmt.Sprintf("cant delete from slice with len (%d) requested index (%d)", len(slice), index))
+	}
+	slice[index] = slice[len(slice)-1]
+
+	return slice[:len(slice)-1]
+}
@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+)
+
+var sweepCSVHeader = []string{
+	"processors", "buffer_capacity", "producer_interval_sec", "incedents",
+	"p_rejected", "mean_wait_sec", "time_in_system_sec", "utilization", "meets_threshold",
+}
+
+// SweepWriter writes results of all sweep points into one csv table
+type SweepWriter struct {
+	path string
+}
+
+func NewSweepWriter(path string) *SweepWriter {
+	return &SweepWriter{
+		path: path,
+	}
+}
+
+func (sw *SweepWriter) Write(results []domain.SweepResult) error {
+	if err := os.MkdirAll(filepath.Dir(sw.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create results directory: %w", err)
+	}
+	file, err := os.Create(sw.path)
+	if err != nil {
+		return fmt.Errorf("failed to create results table: %w", err)
+	}
+	defer file.Close()
+
+	w := csv.NewWriter(file)
+	if err := w.Write(sweepCSVHeader); err != nil {
+		return fmt.Errorf("failed to write results table: %w", err)
+	}
+	for _, result := range results {
+		err := w.Write([]string{
+			strconv.Itoa(result.Point.Processors),
+			strconv.FormatUint(result.Point.BufferCapacity, 10),
+			formatFloat(result.Point.ProducerInterval.Seconds()),
+			strconv.Itoa(result.Summary.Total),
+			formatFloat(result.Summary.PRejected),
+			formatFloat(result.Summary.MeanWait.Seconds()),
+			formatFloat(result.Summary.TimeInSystem.Seconds()),
+			formatFloat(result.Summary.Utilization),
+			strconv.FormatBool(result.MeetsThreshold),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to write results table: %w", err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("failed to write results table: %w", err)
+	}
+
+	return file.Close()
+}
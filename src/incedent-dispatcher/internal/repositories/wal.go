@@ -0,0 +1,305 @@
+package repositories
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+var errWALClosed = errors.New("write-ahead log is closed")
+
+type walOperation string
+
+const (
+	walPut    walOperation = "put"
+	walDelete walOperation = "delete"
+)
+
+type walRecord struct {
+	Op       walOperation      `json:"op"`
+	Id       uint64            `json:"id"`
+	Priority domain.Priority   `json:"priority"`
+	Time     time.Time         `json:"time"`
+	Source   string            `json:"source,omitempty"`
+	Category string            `json:"category,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	// Payload is base64 encoded by json
+	Payload     []byte `json:"payload,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+}
+
+// newWALRecord keeps metadata only for put records, delete needs just a key
+func newWALRecord(op walOperation, incedent domain.Incedent) walRecord {
+	record := walRecord{
+		Op:       op,
+		Id:       incedent.Id,
+		Priority: incedent.Priority,
+		Time:     incedent.CreationTime,
+	}
+	if op == walPut {
+		record.Source = incedent.Source
+		record.Category = incedent.Category
+		record.Labels = incedent.Labels
+		record.Payload = incedent.Payload.Data
+		record.ContentType = incedent.Payload.ContentType
+	}
+
+	return record
+}
+
+func (r walRecord) incedent() domain.Incedent {
+	return domain.Incedent{
+		Id:           r.Id,
+		Priority:     r.Priority,
+		CreationTime: r.Time,
+		Source:       r.Source,
+		Category:     r.Category,
+		Labels:       r.Labels,
+		Payload: domain.Payload{
+			ContentType: r.ContentType,
+			Data:        r.Payload,
+		},
+	}
+}
+
+// WriteAheadLog appends buffer changes to the file, so buffered incedents
+// can be restored after restart. The file is compacted periodically to keep
+// only incedents which are still in the buffer.
+type WriteAheadLog struct {
+	log                *logger.Logger
+	clk                clock.Clock
+	path               string
+	sync               bool
+	compactionInterval time.Duration
+
+	mu   sync.Mutex
+	file *os.File
+	live map[incedentKey]domain.Incedent
+}
+
+// NewWriteAheadLog replays existing log from path and opens it for appending
+func NewWriteAheadLog(
+	log *logger.Logger,
+	clk clock.Clock,
+	path string,
+	sync bool,
+	compactionInterval time.Duration,
+) (*WriteAheadLog, error) {
+	w := &WriteAheadLog{
+		log:                log,
+		clk:                clk,
+		path:               path,
+		sync:               sync,
+		compactionInterval: compactionInterval,
+		live:               make(map[incedentKey]domain.Incedent),
+	}
+	if err := w.replay(); err != nil {
+		return nil, err
+	}
+	if err := w.compact(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (w *WriteAheadLog) Run(ctx context.Context) error {
+	ticker := w.clk.Ticker(w.compactionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := w.compactIfOpened(); err != nil {
+				w.log.Error("Failed to compact write-ahead log", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (w *WriteAheadLog) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return
+	}
+	if err := w.compact(); err != nil {
+		w.log.Error("Failed to compact write-ahead log", zap.Error(err))
+	}
+	if err := w.file.Close(); err != nil {
+		w.log.Error("Failed to close write-ahead log", zap.Error(err))
+	}
+	w.file = nil
+}
+
+// Incedents returns incedents which are alive in the log, from the oldest one
+func (w *WriteAheadLog) Incedents() []domain.Incedent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	incedents := make([]domain.Incedent, 0, len(w.live))
+	for _, incedent := range w.live {
+		incedents = append(incedents, incedent)
+	}
+	slices.SortFunc(incedents, func(a, b domain.Incedent) int {
+		return a.CreationTime.Compare(b.CreationTime)
+	})
+
+	return incedents
+}
+
+func (w *WriteAheadLog) Put(incedent domain.Incedent) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err := w.append(newWALRecord(walPut, incedent)); err != nil {
+		return err
+	}
+	w.live[keyOf(incedent)] = incedent
+
+	return nil
+}
+
+func (w *WriteAheadLog) Delete(incedent domain.Incedent) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, ok := w.live[keyOf(incedent)]; !ok {
+		return nil
+	}
+	if err := w.append(newWALRecord(walDelete, incedent)); err != nil {
+		return err
+	}
+	delete(w.live, keyOf(incedent))
+
+	return nil
+}
+
+func (w *WriteAheadLog) append(record walRecord) error {
+	if w.file == nil {
+		return errWALClosed
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode wal record: %w", err)
+	}
+	if _, err = w.file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to append wal record: %w", err)
+	}
+	if w.sync {
+		if err = w.file.Sync(); err != nil {
+			return fmt.Errorf("failed to sync wal: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (w *WriteAheadLog) replay() error {
+	file, err := os.Open(w.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open wal %s: %w", w.path, err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) && len(data) == 0 {
+			break
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("failed to read wal %s: %w", w.path, err)
+		}
+
+		var record walRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			// tail could be written partially before crash
+			w.log.Warn("Skipping corrupted wal record", zap.Int("line", line), zap.Error(err))
+			continue
+		}
+		switch record.Op {
+		case walPut:
+			w.live[keyOf(record.incedent())] = record.incedent()
+		case walDelete:
+			delete(w.live, keyOf(record.incedent()))
+		default:
+			w.log.Warn("Skipping unknown wal record", zap.Int("line", line), zap.Any("op", record.Op))
+		}
+	}
+	w.log.Info("Write-ahead log replayed", zap.String("path", w.path), zap.Int("incedents", len(w.live)))
+
+	return nil
+}
+
+func (w *WriteAheadLog) compactIfOpened() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return errWALClosed
+	}
+
+	return w.compact()
+}
+
+// compact rewrites the log with alive incedents only, must be called under lock
+func (w *WriteAheadLog) compact() error {
+	tmpPath := w.path + ".tmp"
+	tmp, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("failed to create wal %s: %w", tmpPath, err)
+	}
+	writer := bufio.NewWriter(tmp)
+	for _, incedent := range w.live {
+		data, err := json.Marshal(newWALRecord(walPut, incedent))
+		if err != nil {
+			tmp.Close()
+			return fmt.Errorf("failed to encode wal record: %w", err)
+		}
+		writer.Write(append(data, '\n'))
+	}
+	if err = writer.Flush(); err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write wal %s: %w", tmpPath, err)
+	}
+
+	if w.file != nil {
+		w.file.Close()
+	}
+	renameErr := os.Rename(tmpPath, w.path)
+	// keep appending to the old log if it wasn't replaced
+	w.file, err = os.OpenFile(w.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open wal %s: %w", w.path, err)
+	}
+	if renameErr != nil {
+		return fmt.Errorf("failed to replace wal %s: %w", w.path, renameErr)
+	}
+
+	return nil
+}
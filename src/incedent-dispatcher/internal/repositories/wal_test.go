@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+var _ = Describe("WriteAheadLog", func() {
+	var (
+		log       = logger.InitZapWrapper(zap.NewNop())
+		path      string
+		incedents = []domain.Incedent{
+			newTestIncedent(1, 1, 0),
+			newTestIncedent(2, 2, time.Second),
+			newTestIncedent(3, 1, 2*time.Second),
+		}
+	)
+
+	openWAL := func() *WriteAheadLog {
+		wal, err := NewWriteAheadLog(log, clock.NewMock(), path, true, time.Minute)
+		Expect(err).To(Succeed())
+
+		return wal
+	}
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "dispatcher.wal")
+	})
+
+	It("Replays alive incedents after restart", func() {
+		wal := openWAL()
+		for _, incedent := range incedents {
+			Expect(wal.Put(incedent)).To(Succeed())
+		}
+		Expect(wal.Delete(incedents[1])).To(Succeed())
+		wal.Stop()
+
+		Expect(openWAL().Incedents()).To(Equal([]domain.Incedent{incedents[0], incedents[2]}))
+	})
+
+	It("Keeps incedent metadata", func() {
+		incedent := incedents[0]
+		incedent.Source = "producer-1"
+		incedent.Category = "network"
+		incedent.Labels = map[string]string{"region": "eu"}
+		incedent.Payload = domain.Payload{ContentType: "application/octet-stream", Data: []byte{0, 1, 2}}
+		wal := openWAL()
+		Expect(wal.Put(incedent)).To(Succeed())
+		wal.Stop()
+
+		Expect(openWAL().Incedents()).To(Equal([]domain.Incedent{incedent}))
+	})
+
+	It("Skips partially written tail", func() {
+		wal := openWAL()
+		Expect(wal.Put(incedents[0])).To(Succeed())
+		wal.Stop()
+
+		file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+		Expect(err).To(Succeed())
+		_, err = file.WriteString(`{"op":"put","id":2,"prio`)
+		Expect(err).To(Succeed())
+		Expect(file.Close()).To(Succeed())
+
+		Expect(openWAL().Incedents()).To(Equal([]domain.Incedent{incedents[0]}))
+	})
+
+	It("Buffer restores incedents from log", func() {
+		wal := openWAL()
+		storage := NewBufferStorage(log, 2, lowerPriorityPolicy{}, wal, nil)
+		for _, incedent := range incedents[:2] {
+			Expect(storage.CheckAndPut(incedent)).To(Succeed())
+		}
+		Expect(storage.EvictAndPut(incedents[2])).To(Equal(incedents[0]))
+		wal.Stop()
+
+		restored := NewBufferStorage(log, 1, lowerPriorityPolicy{}, openWAL(), nil)
+		Expect(restored.Restore()).To(Equal([]domain.Incedent{incedents[1]}))
+		Expect(restored.size()).To(Equal(1))
+	})
+})
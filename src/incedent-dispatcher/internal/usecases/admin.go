@@ -0,0 +1,138 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/benbjohnson/clock"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+type incedentEvicter interface {
+	EvictIncedents(incedents []domain.Incedent)
+}
+
+type processorRetirer interface {
+	Retire(ctx context.Context, processorID uint64) error
+}
+
+// AdminUseCase changes dispatcher settings without restart,
+// drained processors are retired once their incedents are finished
+type AdminUseCase struct {
+	log            *logger.Logger
+	clk            clock.Clock
+	bAdmin         bufferAdmin
+	pAdmin         processorsAdmin
+	metricsStorage metricsStorage
+	evicter        incedentEvicter
+	retirer        processorRetirer
+}
+
+func NewAdminUseCase(
+	log *logger.Logger,
+	clk clock.Clock,
+	bAdmin bufferAdmin,
+	pAdmin processorsAdmin,
+	metricsStorage metricsStorage,
+	evicter incedentEvicter,
+	retirer processorRetirer,
+) *AdminUseCase {
+	return &AdminUseCase{
+		log:            log,
+		clk:            clk,
+		bAdmin:         bAdmin,
+		pAdmin:         pAdmin,
+		metricsStorage: metricsStorage,
+		evicter:        evicter,
+		retirer:        retirer,
+	}
+}
+
+func (au *AdminUseCase) Run(ctx context.Context) error {
+	ticker := au.clk.Ticker(watchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			au.deregisterDrained(ctx)
+		}
+	}
+}
+
+func (au *AdminUseCase) Stop() {}
+
+// SetBufferCapacity returns incedents evicted by shrinking, they are finished with error
+func (au *AdminUseCase) SetBufferCapacity(_ context.Context, capacity uint64) ([]domain.Incedent, error) {
+	if capacity == 0 {
+		return nil, fmt.Errorf("buffer capacity must be positive: %w", domain.ErrBadSetting)
+	}
+	evicted := au.bAdmin.SetCapacity(capacity)
+	au.metricsStorage.ConfigChanged(domain.SettingBufferCapacity, strconv.FormatUint(capacity, 10))
+	au.evicter.EvictIncedents(evicted)
+
+	return evicted, nil
+}
+
+func (au *AdminUseCase) SetEvictionPolicy(_ context.Context, policy string) error {
+	if err := au.bAdmin.SetEvictionPolicy(policy); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrBadSetting, err)
+	}
+	au.metricsStorage.ConfigChanged(domain.SettingEvictionPolicy, policy)
+
+	return nil
+}
+
+func (au *AdminUseCase) SetSelectionStrategy(_ context.Context, strategy string) error {
+	if err := au.pAdmin.SetSelectionStrategy(strategy); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrBadSetting, err)
+	}
+	au.metricsStorage.ConfigChanged(domain.SettingSelectionStrategy, strategy)
+
+	return nil
+}
+
+func (au *AdminUseCase) ListProcessors(_ context.Context) []domain.ProcessorStatus {
+	return au.pAdmin.Statuses()
+}
+
+func (au *AdminUseCase) GetBuffer(_ context.Context) domain.BufferState {
+	return au.bAdmin.State()
+}
+
+// DrainProcessor stops sending new incedents to processor, it returns right away
+func (au *AdminUseCase) DrainProcessor(_ context.Context, processorID uint64) error {
+	if !au.pAdmin.Drain(processorID) {
+		return fmt.Errorf("drain of processor %d: %w", processorID, domain.ErrUnknownProcessor)
+	}
+	au.metricsStorage.ConfigChanged(domain.SettingDrainProcessor, strconv.FormatUint(processorID, 10))
+
+	return nil
+}
+
+// DeregisterProcessor removes processor without waiting for its incedents,
+// it doesn't register again on the next heartbeat
+func (au *AdminUseCase) DeregisterProcessor(ctx context.Context, processorID uint64) error {
+	if err := au.retirer.Retire(ctx, processorID); err != nil {
+		return err
+	}
+	au.metricsStorage.ConfigChanged(domain.SettingDeregisterProcessor, strconv.FormatUint(processorID, 10))
+
+	return nil
+}
+
+func (au *AdminUseCase) deregisterDrained(ctx context.Context) {
+	for _, processor := range au.pAdmin.Drained() {
+		if err := au.retirer.Retire(ctx, processor.Processor.Id); err != nil {
+			au.log.Warn("Failed to deregister drained processor", zap.Stringer("processor", processor), zap.Error(err))
+			continue
+		}
+		au.log.Info("Processor drained", zap.Stringer("processor", processor))
+	}
+}
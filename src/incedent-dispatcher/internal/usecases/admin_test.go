@@ -0,0 +1,124 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/repositories"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+var _ = Describe("Admin", func() {
+	var (
+		clk          *clock.Mock
+		pStorage     *repositories.ProcessorStorage
+		mStorage     *repositories.MetricsStorage
+		dispatcher   *IncedentDispatcher
+		registration *RegistrationUseCase
+		admin        *AdminUseCase
+	)
+
+	BeforeEach(func() {
+		log := logger.InitZapWrapper(zap.NewNop())
+		clk = clock.NewMock()
+		policy, err := repositories.NewEvictionPolicy(repositories.DropOldestPolicy)
+		Expect(err).To(Succeed())
+		bStorage := repositories.NewBufferStorage(log, 3, policy, nil, nil)
+		strategy, err := repositories.NewSelectionStrategy(repositories.RoundRobinStrategy)
+		Expect(err).To(Succeed())
+		pStorage = repositories.NewProcessorStorage(clk, strategy)
+		mStorage = repositories.NewMetricsStorage(log, clk, domain.Precision{Confidence: 0.95}, domain.WarmUp{})
+		var journal *repositories.Journal
+		dispatcher = NewIncedentDispatcher(log, clk, bStorage, pStorage, mStorage, journal, domain.RetryPolicy{MaxAttempts: 1})
+		registration = NewRegistrationUseCase(log, clk, pStorage, mStorage, time.Hour)
+		admin = NewAdminUseCase(log, clk, bStorage, pStorage, mStorage, dispatcher, registration)
+	})
+
+	It("Shrinking buffer rejects evicted incedents", func() {
+		tickets := make([]domain.Ticket, 0)
+		for id := range uint64(3) {
+			incedent := domain.Incedent{Id: id, Priority: 1, CreationTime: clk.Now()}
+			ticket, err := dispatcher.SubmitIncedent(context.Background(), incedent)
+			Expect(err).To(Succeed())
+			tickets = append(tickets, ticket)
+			clk.Add(time.Second)
+		}
+
+		_, err := admin.SetBufferCapacity(context.Background(), 0)
+		Expect(err).To(MatchError(domain.ErrBadSetting))
+		evicted, err := admin.SetBufferCapacity(context.Background(), 2)
+		Expect(err).To(Succeed())
+		Expect(evicted).To(HaveLen(1))
+		Expect(evicted[0].Id).To(BeZero())
+		Eventually(func() domain.IncedentStatus {
+			status, _ := dispatcher.GetIncedentStatus(context.Background(), tickets[0])
+			return status
+		}).Should(Equal(domain.Rejected))
+		status, err := dispatcher.GetIncedentStatus(context.Background(), tickets[1])
+		Expect(err).To(Succeed())
+		Expect(status).To(Equal(domain.InBuffer))
+
+		Expect(admin.SetEvictionPolicy(context.Background(), "unknown")).To(MatchError(domain.ErrBadSetting))
+		Expect(admin.SetSelectionStrategy(context.Background(), repositories.RandomStrategy)).To(Succeed())
+		changes := mStorage.Report().ConfigChanges
+		Expect(changes).To(HaveLen(2))
+		Expect(changes[0]).To(Equal(domain.ConfigChange{
+			Time:    clk.Now(),
+			Setting: domain.SettingBufferCapacity,
+			Value:   "2",
+		}))
+		Expect(changes[1].Setting).To(Equal(domain.SettingSelectionStrategy))
+	})
+
+	It("Drained processor is retired", func() {
+		processor := domain.IncedentProcessor{Id: 1, Host: "localhost:0"}
+		Expect(registration.Register(context.Background(), processor)).To(Succeed())
+		Expect(admin.DrainProcessor(context.Background(), 2)).To(MatchError(domain.ErrUnknownProcessor))
+		Expect(admin.DrainProcessor(context.Background(), processor.Id)).To(Succeed())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			Expect(admin.Run(ctx)).To(Succeed())
+		}()
+		Eventually(func() bool {
+			clk.Add(watchInterval)
+			return pStorage.Has(processor.Id)
+		}).Should(BeFalse())
+		Expect(mStorage.Report().Processors[0].Deregistered).To(BeTrue())
+		// drained processor is told not to register again
+		Expect(registration.Heartbeat(context.Background(), processor.Id)).To(MatchError(domain.ErrProcessorRetired))
+		Expect(registration.Heartbeat(context.Background(), 2)).To(MatchError(domain.ErrUnknownProcessor))
+
+		// explicit registration brings it back
+		Expect(registration.Register(context.Background(), processor)).To(Succeed())
+		Expect(registration.Heartbeat(context.Background(), processor.Id)).To(Succeed())
+
+		cancel()
+		Eventually(done).Should(BeClosed())
+		registration.Stop()
+	})
+
+	It("Deregistered processor is retired", func() {
+		processor := domain.IncedentProcessor{Id: 1, Host: "localhost:0"}
+		Expect(registration.Register(context.Background(), processor)).To(Succeed())
+		Expect(admin.DeregisterProcessor(context.Background(), 2)).To(MatchError(domain.ErrUnknownProcessor))
+		Expect(admin.DeregisterProcessor(context.Background(), processor.Id)).To(Succeed())
+
+		Expect(pStorage.Has(processor.Id)).To(BeFalse())
+		Expect(registration.Heartbeat(context.Background(), processor.Id)).To(MatchError(domain.ErrProcessorRetired))
+		Expect(mStorage.Report().ConfigChanges).To(ConsistOf(domain.ConfigChange{
+			Time:    clk.Now(),
+			Setting: domain.SettingDeregisterProcessor,
+			Value:   "1",
+		}))
+		registration.Stop()
+	})
+})
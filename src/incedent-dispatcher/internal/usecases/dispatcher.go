@@ -2,7 +2,9 @@ package usecases
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -15,8 +17,9 @@ import (
 )
 
 const (
-	processorsChanCapacity = 10
-	stopTimer              = 5 * time.Second
+	stopTimer     = 5 * time.Second
+	watchInterval = 100 * time.Millisecond
+	ticketKeySize = 32
 )
 
 var (
@@ -35,11 +38,13 @@ type IncedentDispatcher struct {
 	bStorage       bufferStorage
 	pStorage       processorsStorage
 	metricsStorage metricsStorage
+	journal        eventJournal
+	retryPolicy    domain.RetryPolicy
+	tickets        domain.TicketSigner
 
-	stopped             chan struct{}
-	mu                  sync.Mutex
-	incedents           map[incedentInfo]chan error
-	availableProcessors chan domain.ProcessorClientInfo
+	stopped   chan struct{}
+	mu        sync.Mutex
+	incedents map[incedentInfo]chan error
 }
 
 func NewIncedentDispatcher(
@@ -48,19 +53,31 @@ func NewIncedentDispatcher(
 	bStorage bufferStorage,
 	pStorage processorsStorage,
 	metricsStorage metricsStorage,
+	journal eventJournal,
+	retryPolicy domain.RetryPolicy,
 ) *IncedentDispatcher {
+	key := make([]byte, ticketKeySize)
+	if _, err := rand.Read(key); err != nil {
+		// crypto/rand doesn't fail on supported platforms
+		panic(fmt.Errorf("ticket key: %w", err))
+	}
+
 	return &IncedentDispatcher{
 		log:            log,
 		clk:            clk,
 		bStorage:       bStorage,
 		pStorage:       pStorage,
 		metricsStorage: metricsStorage,
+		journal:        journal,
+		retryPolicy:    retryPolicy,
+		tickets:        domain.NewTicketSigner(key),
 		stopped:        make(chan struct{}),
 		incedents:      make(map[incedentInfo]chan error),
 	}
 }
 
 func (ic *IncedentDispatcher) Run(ctx context.Context) error {
+	ic.restoreIncedents()
 	return ic.runProcessing(ctx)
 }
 
@@ -94,22 +111,117 @@ func (ic *IncedentDispatcher) gracefulShutdown() {
 }
 
 func (ic *IncedentDispatcher) NewIncedent(ctx context.Context, incedent domain.Incedent) error {
+	wait, err := ic.submit(incedent)
+	if err != nil {
+		return err
+	}
+
+	return ic.waitResult(incedent, wait)
+}
+
+// SubmitIncedent puts incedent into processing without waiting for the result
+func (ic *IncedentDispatcher) SubmitIncedent(ctx context.Context, incedent domain.Incedent) (domain.Ticket, error) {
+	wait, err := ic.submit(incedent)
+	if err != nil {
+		return "", err
+	}
+	go ic.waitResult(incedent, wait)
+
+	return ic.tickets.NewTicket(incedent), nil
+}
+
+func (ic *IncedentDispatcher) GetIncedentStatus(_ context.Context, ticket domain.Ticket) (domain.IncedentStatus, error) {
+	priority, id, err := ic.tickets.ParseTicket(ticket)
+	if err != nil {
+		return 0, err
+	}
+	status, ok := ic.metricsStorage.IncedentStatus(priority, id)
+	if !ok {
+		return 0, fmt.Errorf("no incedent for ticket '%s': %w", ticket, domain.ErrUnknownIncedent)
+	}
+
+	return status, nil
+}
+
+// WatchIncedent sends every status change of the incedent,
+// channel is closed after final status or when ctx is done.
+func (ic *IncedentDispatcher) WatchIncedent(ctx context.Context, ticket domain.Ticket) (<-chan domain.IncedentStatus, error) {
+	status, changed, err := ic.watchStatus(ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan domain.IncedentStatus, 1)
+	ch <- status
+	go func() {
+		defer close(ch)
+
+		for !status.IsFinal() {
+			select {
+			case <-ctx.Done():
+				return
+			case <-changed:
+			}
+
+			var curr domain.IncedentStatus
+			curr, changed, err = ic.watchStatus(ticket)
+			if err != nil {
+				return
+			}
+			if curr == status {
+				continue
+			}
+			status = curr
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- status:
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// watchStatus returns status of the incedent and channel closed when it changes
+func (ic *IncedentDispatcher) watchStatus(ticket domain.Ticket) (domain.IncedentStatus, <-chan struct{}, error) {
+	priority, id, err := ic.tickets.ParseTicket(ticket)
+	if err != nil {
+		return 0, nil, err
+	}
+	status, changed, ok := ic.metricsStorage.WatchIncedentStatus(priority, id)
+	if !ok {
+		return 0, nil, fmt.Errorf("no incedent for ticket '%s': %w", ticket, domain.ErrUnknownIncedent)
+	}
+
+	return status, changed, nil
+}
+
+func (ic *IncedentDispatcher) submit(incedent domain.Incedent) (chan error, error) {
 	select {
 	case <-ic.stopped:
 		ic.log.Warn(
 			"Rejected to process incedent, service terminating",
 			zap.Stringer("incedent", incedent),
 		)
-		return errServiceUnavailable
+		return nil, errServiceUnavailable
 	default:
 	}
 
 	ic.log.Info("New incedent received", zap.Stringer("incedent", incedent))
 	ic.metricsStorage.ReceivedIncedent(incedent)
+	ic.journal.Record(domain.EventArrived, incedent, 0)
 
-	wait := ic.newIncedent(incedent)
+	return ic.newIncedent(incedent), nil
+}
+
+func (ic *IncedentDispatcher) waitResult(incedent domain.Incedent, wait chan error) error {
 	if err := <-wait; err != nil {
 		ic.metricsStorage.IncedentRejected(incedent)
+		// eviction is journalled by the buffer
+		if !errors.Is(err, errIncedentEvicted) {
+			ic.journal.Record(domain.EventRejected, incedent, 0)
+		}
 		ic.log.Warn(
 			"Incedent processed with error",
 			zap.Stringer("incedent", incedent),
@@ -126,8 +238,22 @@ func (ic *IncedentDispatcher) NewIncedent(ctx context.Context, incedent domain.I
 	return nil
 }
 
+// restoreIncedents takes incedents survived restart, nobody waits for their results
+func (ic *IncedentDispatcher) restoreIncedents() {
+	restored := ic.bStorage.Restore()
+	for _, incedent := range restored {
+		ic.metricsStorage.ReceivedIncedent(incedent)
+		ic.createNewWaitChan(incedentInfo{
+			id:       incedent.Id,
+			priority: incedent.Priority,
+		})
+	}
+	if len(restored) > 0 {
+		ic.log.Info("Incedents restored from write-ahead log", zap.Int("number", len(restored)))
+	}
+}
+
 func (ic *IncedentDispatcher) runProcessing(ctx context.Context) error {
-	ic.availableProcessors = ic.fetchProcessors(ctx)
 	var once sync.Once
 	for {
 		select {
@@ -138,8 +264,14 @@ func (ic *IncedentDispatcher) runProcessing(ctx context.Context) error {
 		default:
 		}
 
+		added := ic.bStorage.Added()
 		packet := ic.bStorage.GetPacket()
 		if len(packet) == 0 {
+			select {
+			case <-added:
+			case <-ctx.Done():
+			case <-ic.stopped:
+			}
 			continue
 		}
 
@@ -159,14 +291,7 @@ func (ic *IncedentDispatcher) newIncedent(incedent domain.Incedent) chan error {
 	}
 
 	// there is a chance to evict incedent in process
-	evicted := ic.bStorage.EvictAndPut(incedent)
-	ic.sendResult(
-		incedentInfo{
-			id:       evicted.Id,
-			priority: evicted.Priority,
-		},
-		errIncedentEvicted,
-	)
+	ic.evict(ic.bStorage.EvictAndPut(incedent))
 
 	return waitChan
 }
@@ -196,57 +321,135 @@ func (ic *IncedentDispatcher) sendResult(info incedentInfo, result error) {
 	delete(ic.incedents, info)
 }
 
-func (ic *IncedentDispatcher) fetchProcessors(_ context.Context) chan domain.ProcessorClientInfo {
-	ch := make(chan domain.ProcessorClientInfo, processorsChanCapacity)
-	var wg sync.WaitGroup
-	wg.Add(1)
-	go func() {
-		defer close(ch)
-		wg.Done()
+// nextProcessor waits for a free slot, processor is chosen when incedent is dispatched,
+// so selection strategy sees actual state. Returns false if ctx is done or dispatcher stopped.
+func (ic *IncedentDispatcher) nextProcessor(ctx context.Context) (domain.ProcessorClientInfo, bool) {
+	return ic.waitProcessor(ctx, ic.pStorage.Acquire)
+}
 
-		for {
-			select {
-			case <-ic.stopped:
-				return
-			default:
-			}
+// retryProcessor is nextProcessor which prefers processors other than the failed one
+func (ic *IncedentDispatcher) retryProcessor(ctx context.Context, failed domain.ProcessorClientInfo) (domain.ProcessorClientInfo, bool) {
+	return ic.waitProcessor(ctx, func() (domain.ProcessorClientInfo, bool) {
+		return ic.pStorage.AcquireAvoiding(failed.Processor.Id)
+	})
+}
 
-			processors := ic.pStorage.Get()
-			for _, p := range processors {
-				if ic.pStorage.IsBusy(p.Processor.Id) {
-					continue
-				}
+func (ic *IncedentDispatcher) waitProcessor(
+	ctx context.Context,
+	acquire func() (domain.ProcessorClientInfo, bool),
+) (domain.ProcessorClientInfo, bool) {
+	for {
+		freed := ic.pStorage.Freed()
+		if processor, ok := acquire(); ok {
+			return processor, true
+		}
 
-				ic.pStorage.SetBusy(p.Processor.Id)
-				ch <- p
-			}
+		select {
+		case <-ctx.Done():
+			return domain.ProcessorClientInfo{}, false
+		case <-ic.stopped:
+			return domain.ProcessorClientInfo{}, false
+		case <-freed:
 		}
-	}()
-	wg.Wait()
+	}
+}
 
-	return ch
+// EvictIncedents finishes incedents evicted from the buffer by settings change
+func (ic *IncedentDispatcher) EvictIncedents(incedents []domain.Incedent) {
+	for _, incedent := range incedents {
+		ic.evict(incedent)
+	}
+}
+
+func (ic *IncedentDispatcher) evict(incedent domain.Incedent) {
+	ic.metricsStorage.IncedentEvicted(incedent)
+	ic.sendResult(
+		incedentInfo{
+			id:       incedent.Id,
+			priority: incedent.Priority,
+		},
+		errIncedentEvicted,
+	)
 }
 
 // processPacket blocks until packet is processed
 func (ic *IncedentDispatcher) processPacket(ctx context.Context, packet []domain.Incedent) {
 	var eg errgroup.Group
 	for _, incedent := range packet {
-		processor := <-ic.availableProcessors
+		processor, ok := ic.nextProcessor(ctx)
+		if !ok {
+			ic.finishIncedent(incedent, errServiceUnavailable)
+			continue
+		}
 		eg.Go(func() error {
-			ic.log.Debug("Processor is BUSY", zap.Stringer("processor", processor))
-			ic.metricsStorage.ProcessInedent(incedent, processor.Processor)
-			err := processor.Client.SendIncedent(ctx, incedent)
-			ic.metricsStorage.IncedentProcessed(incedent, processor.Processor)
-			ic.sendResult(incedentInfo{id: incedent.Id, priority: incedent.Priority}, err)
-			err = ic.bStorage.DeleteIncedent(incedent)
-			if err != nil {
-				ic.log.Fatal("Buffer violation", zap.Error(err))
-			}
-			ic.log.Debug("Processor is FREE", zap.Stringer("processor", processor))
-			ic.pStorage.SetFree(processor.Processor.Id)
-
+			ic.finishIncedent(incedent, ic.sendIncedent(ctx, incedent, processor))
 			return nil
 		})
 	}
 	eg.Wait()
 }
+
+func (ic *IncedentDispatcher) finishIncedent(incedent domain.Incedent, result error) {
+	ic.sendResult(incedentInfo{id: incedent.Id, priority: incedent.Priority}, result)
+	if err := ic.bStorage.DeleteIncedent(incedent); err != nil {
+		ic.log.Fatal("Buffer violation", zap.Error(err))
+	}
+}
+
+// sendIncedent resends incedent to another processor after transport failures,
+// processor is freed as soon as attempt is finished, so waiting for retry holds no slot
+func (ic *IncedentDispatcher) sendIncedent(
+	ctx context.Context,
+	incedent domain.Incedent,
+	processor domain.ProcessorClientInfo,
+) error {
+	for attempt := 1; ; attempt++ {
+		ic.log.Debug("Processor is BUSY", zap.Stringer("processor", processor))
+		ic.metricsStorage.ProcessInedent(incedent, processor.Processor)
+		ic.journal.Record(domain.EventStarted, incedent, processor.Processor.Id)
+		err := processor.Client.SendIncedent(ctx, incedent)
+		if err == nil {
+			ic.metricsStorage.IncedentProcessed(incedent, processor.Processor)
+			ic.journal.Record(domain.EventFinished, incedent, processor.Processor.Id)
+			ic.freeProcessor(processor)
+			return nil
+		}
+		ic.freeProcessor(processor)
+		if !errors.Is(err, domain.ErrTransport) || attempt >= ic.retryPolicy.MaxAttempts {
+			ic.metricsStorage.IncedentFailed(incedent, processor.Processor)
+			return err
+		}
+
+		ic.metricsStorage.IncedentAttemptFailed(incedent, processor.Processor, attempt)
+		ic.journal.Record(domain.EventAttemptFailed, incedent, processor.Processor.Id)
+		backoff := ic.retryPolicy.Backoff(attempt)
+		ic.log.Warn(
+			"Failed to send incedent, retrying",
+			zap.Stringer("incedent", incedent),
+			zap.Stringer("processor", processor),
+			zap.Int("attempt", attempt),
+			zap.Duration("backoff", backoff),
+			zap.Error(err),
+		)
+		timer := ic.clk.Timer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-ic.stopped:
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		var ok bool
+		if processor, ok = ic.retryProcessor(ctx, processor); !ok {
+			return err
+		}
+	}
+}
+
+func (ic *IncedentDispatcher) freeProcessor(processor domain.ProcessorClientInfo) {
+	ic.log.Debug("Processor is FREE", zap.Stringer("processor", processor))
+	ic.pStorage.SetFree(processor.Processor.Id)
+}
@@ -0,0 +1,398 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/repositories"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+// fakeProcessorClient reports started sends and returns results given by the test
+type fakeProcessorClient struct {
+	id      uint64
+	started chan<- uint64
+	results chan error
+}
+
+func (f *fakeProcessorClient) SendIncedent(ctx context.Context, _ domain.Incedent) error {
+	f.started <- f.id
+	select {
+	case err := <-f.results:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+var _ = Describe("IncedentDispatcher", func() {
+	var (
+		clk        *clock.Mock
+		pStorage   *repositories.ProcessorStorage
+		mStorage   *repositories.MetricsStorage
+		journal    *repositories.Journal
+		dispatcher *IncedentDispatcher
+		started    chan uint64
+		clients    map[uint64]*fakeProcessorClient
+		ctx        context.Context
+		cancel     context.CancelFunc
+		done       chan struct{}
+	)
+
+	newDispatcher := func(strategyName string, retryPolicy domain.RetryPolicy, processors ...domain.IncedentProcessor) {
+		log := logger.InitZapWrapper(zap.NewNop())
+		clk = clock.NewMock()
+		policy, err := repositories.NewEvictionPolicy(repositories.DropOldestPolicy)
+		Expect(err).To(Succeed())
+		journal = repositories.NewJournal(clk, 100)
+		bStorage := repositories.NewBufferStorage(log, 10, policy, nil, journal)
+		strategy, err := repositories.NewSelectionStrategy(strategyName)
+		Expect(err).To(Succeed())
+		pStorage = repositories.NewProcessorStorage(clk, strategy)
+		started = make(chan uint64, 10)
+		clients = make(map[uint64]*fakeProcessorClient)
+		for _, processor := range processors {
+			client := &fakeProcessorClient{id: processor.Id, started: started, results: make(chan error, 1)}
+			clients[processor.Id] = client
+			pStorage.Add(domain.ProcessorClientInfo{Processor: processor, Client: client})
+		}
+		mStorage = repositories.NewMetricsStorage(log, clk, domain.Precision{Confidence: 0.95}, domain.WarmUp{})
+		dispatcher = NewIncedentDispatcher(log, clk, bStorage, pStorage, mStorage, journal, retryPolicy)
+		ctx, cancel = context.WithCancel(context.Background())
+		done = nil
+	}
+
+	run := func() {
+		done = make(chan struct{})
+		go func() {
+			defer close(done)
+			_ = dispatcher.Run(ctx)
+		}()
+	}
+
+	newIncedent := func(id uint64) domain.Incedent {
+		return domain.Incedent{Id: id, Priority: 1, CreationTime: clk.Now()}
+	}
+
+	// process sends incedent and lets the chosen processor finish it, returns the processor
+	process := func(id uint64) uint64 {
+		result := make(chan error, 1)
+		go func() {
+			result <- dispatcher.NewIncedent(ctx, newIncedent(id))
+		}()
+		var processorID uint64
+		Eventually(started).Should(Receive(&processorID))
+		clients[processorID].results <- nil
+		Eventually(result).Should(Receive(BeNil()))
+
+		return processorID
+	}
+
+	submit := func(id uint64) chan error {
+		result := make(chan error, 1)
+		go func() {
+			result <- dispatcher.NewIncedent(ctx, newIncedent(id))
+		}()
+
+		return result
+	}
+
+	// waitRetry moves the clock until the next attempt starts, returns its processor and waited time
+	waitRetry := func() (uint64, time.Duration) {
+		start := clk.Now()
+		Consistently(started, 50*time.Millisecond).ShouldNot(Receive())
+		var processorID uint64
+		Eventually(func() chan uint64 {
+			clk.Add(100 * time.Millisecond)
+			return started
+		}).Should(Receive(&processorID))
+
+		return processorID, clk.Now().Sub(start)
+	}
+
+	errRefused := fmt.Errorf("connection refused: %w", domain.ErrTransport)
+	retryPolicy := domain.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second, MaxBackoff: 10 * time.Second}
+
+	AfterEach(func() {
+		cancel()
+		if done != nil {
+			// shutdown timer runs on the mock clock
+			Eventually(func() chan struct{} {
+				clk.Add(stopTimer)
+				return done
+			}).Should(BeClosed())
+		}
+	})
+
+	It("Weighted strategy follows weights", func() {
+		newDispatcher(repositories.WeightedStrategy, domain.RetryPolicy{MaxAttempts: 1},
+			domain.IncedentProcessor{Id: 1, Weight: 3},
+			domain.IncedentProcessor{Id: 2, Weight: 1},
+		)
+		run()
+
+		order := make([]uint64, 0)
+		for id := range uint64(8) {
+			order = append(order, process(id))
+		}
+		Expect(order).To(Equal([]uint64{1, 1, 2, 1, 1, 1, 2, 1}))
+	})
+
+	DescribeTable("Processor is chosen when incedent is dispatched",
+		func(strategy string, expected uint64) {
+			newDispatcher(strategy, domain.RetryPolicy{MaxAttempts: 1},
+				domain.IncedentProcessor{Id: 1},
+				domain.IncedentProcessor{Id: 2},
+			)
+			// both incedents come in one packet and occupy both processors
+			for id := range uint64(2) {
+				_, err := dispatcher.SubmitIncedent(ctx, newIncedent(id))
+				Expect(err).To(Succeed())
+			}
+			run()
+			Eventually(started).Should(Receive())
+			Eventually(started).Should(Receive())
+
+			// the second processor becomes idle earlier
+			for _, id := range []uint64{2, 1} {
+				clk.Add(time.Second)
+				clients[id].results <- nil
+				Eventually(func() int { return pStorage.InFlight(id) }).Should(BeZero())
+			}
+
+			Expect(process(2)).To(Equal(expected))
+		},
+		Entry("round-robin", repositories.RoundRobinStrategy, uint64(1)),
+		Entry("least recently used", repositories.LeastRecentlyUsedStrategy, uint64(2)),
+	)
+
+	It("Retries after growing backoff", func() {
+		newDispatcher(repositories.RoundRobinStrategy, retryPolicy, domain.IncedentProcessor{Id: 1})
+		run()
+
+		result := submit(1)
+		Eventually(started).Should(Receive())
+		clients[1].results <- errRefused
+		processorID, waited := waitRetry()
+		Expect(processorID).To(Equal(uint64(1)))
+		Expect(waited).To(BeNumerically(">=", time.Second))
+
+		clients[1].results <- errRefused
+		_, waited = waitRetry()
+		Expect(waited).To(BeNumerically(">=", 2*time.Second))
+		clients[1].results <- nil
+		Eventually(result).Should(Receive(BeNil()))
+	})
+
+	It("Fails over to another processor", func() {
+		newDispatcher(repositories.RoundRobinStrategy, retryPolicy,
+			domain.IncedentProcessor{Id: 1},
+			domain.IncedentProcessor{Id: 2},
+		)
+		run()
+
+		result := submit(1)
+		var failed uint64
+		Eventually(started).Should(Receive(&failed))
+		clients[failed].results <- errRefused
+		processorID, _ := waitRetry()
+		Expect(processorID).NotTo(Equal(failed))
+		clients[processorID].results <- nil
+		Eventually(result).Should(Receive(BeNil()))
+	})
+
+	It("Gives up after the last attempt", func() {
+		newDispatcher(repositories.RoundRobinStrategy, domain.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Second, MaxBackoff: time.Second},
+			domain.IncedentProcessor{Id: 1},
+		)
+		run()
+
+		result := submit(1)
+		Eventually(started).Should(Receive())
+		clients[1].results <- errRefused
+		waitRetry()
+		clients[1].results <- errRefused
+		Eventually(result).Should(Receive(MatchError(domain.ErrTransport)))
+
+		// other errors aren't retried
+		result = submit(2)
+		Eventually(started).Should(Receive())
+		clients[1].results <- errors.New("bad incedent")
+		Eventually(result).Should(Receive(MatchError("bad incedent")))
+		Expect(pStorage.InFlight(1)).To(BeZero())
+	})
+
+	It("Keeps busy time of failed attempts", func() {
+		newDispatcher(repositories.RoundRobinStrategy, domain.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Second, MaxBackoff: time.Second},
+			domain.IncedentProcessor{Id: 1},
+		)
+		mStorage.RegisteredProcessor(domain.IncedentProcessor{Id: 1})
+		run()
+
+		result := submit(1)
+		Eventually(started).Should(Receive())
+		clk.Add(2 * time.Second)
+		clients[1].results <- errRefused
+		waitRetry()
+		start := clk.Now()
+		clk.Add(3 * time.Second)
+		clients[1].results <- errRefused
+		Eventually(result).Should(Receive(MatchError(domain.ErrTransport)))
+
+		report := mStorage.Report()
+		Expect(report.Processors).To(HaveLen(1))
+		Expect(report.Processors[0].InWork).To(Equal(2*time.Second + clk.Since(start)))
+	})
+
+	It("Waiting for retry holds no slot", func() {
+		newDispatcher(repositories.RoundRobinStrategy, retryPolicy,
+			domain.IncedentProcessor{Id: 1},
+			domain.IncedentProcessor{Id: 2},
+		)
+		// both incedents come in one packet and fail on both processors
+		tickets := make([]domain.Ticket, 0)
+		for id := range uint64(2) {
+			ticket, err := dispatcher.SubmitIncedent(ctx, newIncedent(id))
+			Expect(err).To(Succeed())
+			tickets = append(tickets, ticket)
+		}
+		run()
+		for range 2 {
+			var processorID uint64
+			Eventually(started).Should(Receive(&processorID))
+			clients[processorID].results <- errRefused
+		}
+		Eventually(func() int { return pStorage.InFlight(1) + pStorage.InFlight(2) }).Should(BeZero())
+
+		for range 2 {
+			var processorID uint64
+			Eventually(func() chan uint64 {
+				clk.Add(100 * time.Millisecond)
+				return started
+			}).Should(Receive(&processorID))
+			clients[processorID].results <- nil
+		}
+		for _, ticket := range tickets {
+			Eventually(func() domain.IncedentStatus {
+				status, _ := dispatcher.GetIncedentStatus(ctx, ticket)
+				return status
+			}).Should(Equal(domain.Processed))
+		}
+	})
+
+	It("Stops retrying on shutdown", func() {
+		newDispatcher(repositories.RoundRobinStrategy, retryPolicy, domain.IncedentProcessor{Id: 1})
+		run()
+
+		result := submit(1)
+		Eventually(started).Should(Receive())
+		clients[1].results <- errRefused
+		Eventually(func() int { return pStorage.InFlight(1) }).Should(BeZero())
+		cancel()
+		Eventually(result).Should(Receive(MatchError(domain.ErrTransport)))
+	})
+
+	It("Reports every status of submitted incedent", func() {
+		newDispatcher(repositories.RoundRobinStrategy, retryPolicy, domain.IncedentProcessor{Id: 1})
+		ticket, err := dispatcher.SubmitIncedent(ctx, newIncedent(1))
+		Expect(err).To(Succeed())
+		Expect(dispatcher.GetIncedentStatus(ctx, ticket)).To(Equal(domain.InBuffer))
+		statuses, err := dispatcher.WatchIncedent(ctx, ticket)
+		Expect(err).To(Succeed())
+		Expect(statuses).To(Receive(Equal(domain.InBuffer)))
+
+		run()
+		Eventually(started).Should(Receive())
+		Eventually(statuses).Should(Receive(Equal(domain.InProcessing)))
+		Expect(dispatcher.GetIncedentStatus(ctx, ticket)).To(Equal(domain.InProcessing))
+
+		// failed attempt returns incedent to the buffer until retry
+		clients[1].results <- errRefused
+		Eventually(statuses).Should(Receive(Equal(domain.InBuffer)))
+		waitRetry()
+		Eventually(statuses).Should(Receive(Equal(domain.InProcessing)))
+
+		clients[1].results <- nil
+		Eventually(statuses).Should(Receive(Equal(domain.Processed)))
+		Eventually(statuses).Should(BeClosed())
+		Expect(dispatcher.GetIncedentStatus(ctx, ticket)).To(Equal(domain.Processed))
+
+		// watch of finished incedent sends final status only
+		statuses, err = dispatcher.WatchIncedent(ctx, ticket)
+		Expect(err).To(Succeed())
+		Expect(statuses).To(Receive(Equal(domain.Processed)))
+		Eventually(statuses).Should(BeClosed())
+	})
+
+	It("Reports rejection of evicted incedent", func() {
+		newDispatcher(repositories.RoundRobinStrategy, retryPolicy)
+		tickets := make([]domain.Ticket, 0)
+		for id := range uint64(10) {
+			ticket, err := dispatcher.SubmitIncedent(ctx, newIncedent(id))
+			Expect(err).To(Succeed())
+			tickets = append(tickets, ticket)
+		}
+		statuses, err := dispatcher.WatchIncedent(ctx, tickets[0])
+		Expect(err).To(Succeed())
+		Expect(statuses).To(Receive(Equal(domain.InBuffer)))
+
+		// the oldest incedent is dropped from the full buffer
+		_, err = dispatcher.SubmitIncedent(ctx, newIncedent(10))
+		Expect(err).To(Succeed())
+		Eventually(statuses).Should(Receive(Equal(domain.Rejected)))
+		Eventually(statuses).Should(BeClosed())
+		Expect(dispatcher.GetIncedentStatus(ctx, tickets[1])).To(Equal(domain.InBuffer))
+
+		// eviction is the only outcome journalled
+		events, _ := journal.Events(0)
+		outcomes := make([]domain.EventType, 0)
+		for _, event := range events {
+			if event.Incedent.Id == 0 && event.Type != domain.EventArrived && event.Type != domain.EventBuffered {
+				outcomes = append(outcomes, event.Type)
+			}
+		}
+		Expect(outcomes).To(Equal([]domain.EventType{domain.EventEvicted}))
+	})
+
+	It("Stops watching when ctx is done", func() {
+		newDispatcher(repositories.RoundRobinStrategy, retryPolicy)
+		ticket, err := dispatcher.SubmitIncedent(ctx, newIncedent(1))
+		Expect(err).To(Succeed())
+		watchCtx, stopWatch := context.WithCancel(ctx)
+		statuses, err := dispatcher.WatchIncedent(watchCtx, ticket)
+		Expect(err).To(Succeed())
+		Expect(statuses).To(Receive(Equal(domain.InBuffer)))
+
+		stopWatch()
+		Eventually(statuses).Should(BeClosed())
+	})
+
+	It("Refuses unknown tickets", func() {
+		newDispatcher(repositories.RoundRobinStrategy, retryPolicy)
+		_, err := dispatcher.SubmitIncedent(ctx, newIncedent(1))
+		Expect(err).To(Succeed())
+
+		_, err = dispatcher.GetIncedentStatus(ctx, dispatcher.tickets.NewTicket(newIncedent(2)))
+		Expect(err).To(MatchError(domain.ErrUnknownIncedent))
+		_, err = dispatcher.WatchIncedent(ctx, dispatcher.tickets.NewTicket(newIncedent(2)))
+		Expect(err).To(MatchError(domain.ErrUnknownIncedent))
+
+		// ticket of submitted incedent can't be guessed from priority and id
+		_, err = dispatcher.GetIncedentStatus(ctx, "1-1-0000000000000000")
+		Expect(err).To(MatchError(domain.ErrUnknownIncedent))
+		// ticket of another dispatcher isn't valid
+		other := domain.NewTicketSigner([]byte("other"))
+		_, err = dispatcher.GetIncedentStatus(ctx, other.NewTicket(newIncedent(1)))
+		Expect(err).To(MatchError(domain.ErrUnknownIncedent))
+		_, err = dispatcher.WatchIncedent(ctx, "1-1")
+		Expect(err).To(MatchError(domain.ErrBadTicket))
+	})
+})
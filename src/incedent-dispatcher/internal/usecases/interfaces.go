@@ -1,29 +1,84 @@
 package usecases
 
 import (
+	"time"
+
 	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
 )
 
 type bufferStorage interface {
+	Added() <-chan struct{}
 	CheckAndPut(incedent domain.Incedent) error
 	DeleteIncedent(incedent domain.Incedent) error
 	EvictAndPut(incedent domain.Incedent) domain.Incedent
 	GetPacket() []domain.Incedent
+	Restore() []domain.Incedent
+}
+
+type bufferAdmin interface {
+	SetCapacity(capacity uint64) []domain.Incedent
+	SetEvictionPolicy(name string) error
+	State() domain.BufferState
 }
 
 type processorsStorage interface {
+	Acquire() (domain.ProcessorClientInfo, bool)
+	AcquireAvoiding(processorID uint64) (domain.ProcessorClientInfo, bool)
 	Add(processor domain.ProcessorClientInfo)
+	Drain(processorID uint64) bool
+	Expired(now time.Time) []domain.ProcessorClientInfo
+	Freed() <-chan struct{}
 	Get() []domain.ProcessorClientInfo
-	IsBusy(processorID uint64) bool
-	SetBusy(processorID uint64)
+	Has(processorID uint64) bool
+	InFlight(processorID uint64) int
+	Remove(processorID uint64) (domain.ProcessorClientInfo, bool)
+	Renew(processorID uint64, deadline time.Time) bool
 	SetFree(processorID uint64)
 }
 
+type processorsAdmin interface {
+	Drain(processorID uint64) bool
+	Drained() []domain.ProcessorClientInfo
+	SetSelectionStrategy(name string) error
+	Statuses() []domain.ProcessorStatus
+}
+
 type metricsStorage interface {
+	ConfigChanged(setting, value string)
+	DeregisteredProcessor(processor domain.IncedentProcessor, expired bool)
+	IncedentAttemptFailed(incedent domain.Incedent, processor domain.IncedentProcessor, attempt int)
+	IncedentEvicted(incedent domain.Incedent)
+	IncedentFailed(incedent domain.Incedent, processor domain.IncedentProcessor)
 	IncedentProcessed(incedent domain.Incedent, processor domain.IncedentProcessor)
 	IncedentRejected(incedent domain.Incedent)
+	IncedentStatus(priority domain.Priority, id uint64) (domain.IncedentStatus, bool)
 	PrintStatistics()
 	ProcessInedent(incedent domain.Incedent, processor domain.IncedentProcessor)
 	ReceivedIncedent(incedent domain.Incedent)
 	RegisteredProcessor(processor domain.IncedentProcessor)
+	Report() domain.Report
+	Reset()
+	WatchIncedentStatus(priority domain.Priority, id uint64) (domain.IncedentStatus, <-chan struct{}, bool)
+}
+
+type eventJournal interface {
+	Record(eventType domain.EventType, incedent domain.Incedent, processorID uint64)
+}
+
+type journalStorage interface {
+	Enabled() bool
+	Events(from uint64) ([]domain.JournalEvent, <-chan struct{})
+}
+
+type journalWriter interface {
+	Close() error
+	Write(events []domain.JournalEvent) error
+}
+
+type reportWriter interface {
+	Write(report domain.Report) error
+}
+
+type sweepWriter interface {
+	Write(results []domain.SweepResult) error
 }
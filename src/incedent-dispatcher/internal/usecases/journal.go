@@ -0,0 +1,104 @@
+package usecases
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+// JournalUseCase streams events of the dispatcher journal
+type JournalUseCase struct {
+	log     *logger.Logger
+	journal journalStorage
+}
+
+func NewJournalUseCase(log *logger.Logger, journal journalStorage) *JournalUseCase {
+	return &JournalUseCase{
+		log:     log,
+		journal: journal,
+	}
+}
+
+// WatchJournal sends kept events starting from sequence number from and then
+// every new one, channel is closed when ctx is done
+func (ju *JournalUseCase) WatchJournal(ctx context.Context, from uint64) (<-chan domain.JournalEvent, error) {
+	if !ju.journal.Enabled() {
+		return nil, domain.ErrJournalDisabled
+	}
+
+	ch := make(chan domain.JournalEvent)
+	go func() {
+		defer close(ch)
+		next := from
+		for {
+			events, updated := ju.journal.Events(next)
+			for _, event := range events {
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- event:
+				}
+				next = event.Seq + 1
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-updated:
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// JournalDumpUseCase appends journal events to the writer while dispatcher works
+type JournalDumpUseCase struct {
+	log     *logger.Logger
+	journal journalStorage
+	writer  journalWriter
+	next    uint64
+}
+
+func NewJournalDumpUseCase(log *logger.Logger, journal journalStorage, writer journalWriter) *JournalDumpUseCase {
+	return &JournalDumpUseCase{
+		log:     log,
+		journal: journal,
+		writer:  writer,
+	}
+}
+
+func (ju *JournalDumpUseCase) Run(ctx context.Context) error {
+	for {
+		updated := ju.dump()
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-updated:
+		}
+	}
+}
+
+// Stop writes events recorded during shutdown and closes the writer
+func (ju *JournalDumpUseCase) Stop() {
+	ju.dump()
+	if err := ju.writer.Close(); err != nil {
+		ju.log.Error("Failed to close journal", zap.Error(err))
+	}
+}
+
+func (ju *JournalDumpUseCase) dump() <-chan struct{} {
+	events, updated := ju.journal.Events(ju.next)
+	if len(events) == 0 {
+		return updated
+	}
+	if err := ju.writer.Write(events); err != nil {
+		ju.log.Error("Failed to dump journal", zap.Error(err))
+	}
+	ju.next = events[len(events)-1].Seq + 1
+
+	return updated
+}
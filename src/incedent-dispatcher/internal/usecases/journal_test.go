@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/repositories"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+var _ = Describe("Journal", func() {
+	log := logger.InitZapWrapper(zap.NewNop())
+
+	It("Streams kept and new events", func() {
+		journal := repositories.NewJournal(clock.NewMock(), 10)
+		journal.Record(domain.EventArrived, domain.Incedent{Id: 1, Priority: 1}, 0)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		events, err := NewJournalUseCase(log, journal).WatchJournal(ctx, 0)
+		Expect(err).To(Succeed())
+		Eventually(events).Should(Receive(HaveField("Seq", uint64(1))))
+		journal.Record(domain.EventBuffered, domain.Incedent{Id: 1, Priority: 1}, 0)
+		Eventually(events).Should(Receive(HaveField("Type", domain.EventBuffered)))
+
+		cancel()
+		Eventually(events).Should(BeClosed())
+	})
+
+	It("Refuses to watch disabled journal", func() {
+		var journal *repositories.Journal
+		_, err := NewJournalUseCase(log, journal).WatchJournal(context.Background(), 0)
+		Expect(err).To(MatchError(domain.ErrJournalDisabled))
+	})
+})
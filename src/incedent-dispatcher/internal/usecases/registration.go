@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/benbjohnson/clock"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -15,33 +17,62 @@ import (
 	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
 )
 
+const (
+	// how many times leases are checked during lease duration
+	leaseChecksNumber = 4
+)
+
 type closeConnection func() error
 
+// connector makes client of processor and function closing its connection
+type connector func(host string) (*clients.ProcessorClient, closeConnection, error)
+
 type RegistrationUseCase struct {
 	log               *logger.Logger
+	clk               clock.Clock
 	processorsStorage processorsStorage
 	metricsStorage    metricsStorage
+	leaseDuration     time.Duration
+	connect           connector
 
 	mu          sync.Mutex
-	connections []closeConnection
+	connections map[uint64]closeConnection
+	// retired processors were deregistered by admin, their heartbeats
+	// are rejected for good until they register again
+	retired map[uint64]struct{}
 }
 
 func NewRegistrationUseCase(
 	log *logger.Logger,
+	clk clock.Clock,
 	processorsStorage processorsStorage,
 	metricsStorage metricsStorage,
+	leaseDuration time.Duration,
 ) *RegistrationUseCase {
 	return &RegistrationUseCase{
 		log:               log,
+		clk:               clk,
 		processorsStorage: processorsStorage,
 		metricsStorage:    metricsStorage,
-		connections:       make([]closeConnection, 0),
+		leaseDuration:     leaseDuration,
+		connect:           connectProcessor,
+		connections:       make(map[uint64]closeConnection),
+		retired:           make(map[uint64]struct{}),
 	}
 }
 
 func (ru *RegistrationUseCase) Run(ctx context.Context) error {
-	<-ctx.Done()
-	return nil
+	ticker := ru.clk.Ticker(ru.leaseDuration / leaseChecksNumber)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			ru.removeExpired()
+		}
+	}
 }
 
 func (ru *RegistrationUseCase) Stop() {
@@ -49,7 +80,7 @@ func (ru *RegistrationUseCase) Stop() {
 }
 
 func (ru *RegistrationUseCase) Register(ctx context.Context, processor domain.IncedentProcessor) error {
-	client, err := ru.createClient(processor.Host)
+	client, closeConn, err := ru.connect(processor.Host)
 	if err != nil {
 		return err
 	}
@@ -60,27 +91,140 @@ func (ru *RegistrationUseCase) Register(ctx context.Context, processor domain.In
 		Client:    client,
 	}
 	ru.processorsStorage.Add(clientInfo)
+	ru.processorsStorage.Renew(processor.Id, ru.clk.Now().Add(ru.leaseDuration))
+	ru.saveConnection(processor.Id, closeConn)
+	ru.setRetired(processor.Id, false)
 	ru.log.Info("New processor registered", zap.Stringer("processor", processor))
 
 	return nil
 }
 
-func (ru *RegistrationUseCase) createClient(host string) (*clients.ProcessorClient, error) {
+func (ru *RegistrationUseCase) Heartbeat(ctx context.Context, processorID uint64) error {
+	if !ru.processorsStorage.Renew(processorID, ru.clk.Now().Add(ru.leaseDuration)) {
+		if ru.isRetired(processorID) {
+			return fmt.Errorf("heartbeat from processor %d: %w", processorID, domain.ErrProcessorRetired)
+		}
+		return fmt.Errorf("heartbeat from processor %d: %w", processorID, domain.ErrUnknownProcessor)
+	}
+
+	return nil
+}
+
+// Deregister stops sending incedents to processor and removes it once its incedents
+// are finished, so they aren't cancelled with the connection. If ctx is done earlier
+// processor is left draining and it is retired when it finishes its incedents
+func (ru *RegistrationUseCase) Deregister(ctx context.Context, processorID uint64) error {
+	if !ru.processorsStorage.Drain(processorID) {
+		return fmt.Errorf("deregistration of processor %d: %w", processorID, domain.ErrUnknownProcessor)
+	}
+	if err := ru.waitDrained(ctx, processorID); err != nil {
+		return fmt.Errorf("deregistration of processor %d: %w", processorID, err)
+	}
+
+	return ru.remove(processorID)
+}
+
+// Retire deregisters processor on behalf of admin without waiting for its incedents,
+// processor is told not to register again in response to its next heartbeat
+func (ru *RegistrationUseCase) Retire(ctx context.Context, processorID uint64) error {
+	if err := ru.remove(processorID); err != nil {
+		return err
+	}
+	ru.setRetired(processorID, true)
+
+	return nil
+}
+
+func (ru *RegistrationUseCase) waitDrained(ctx context.Context, processorID uint64) error {
+	for {
+		freed := ru.processorsStorage.Freed()
+		if ru.processorsStorage.InFlight(processorID) == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-freed:
+		}
+	}
+}
+
+func (ru *RegistrationUseCase) remove(processorID uint64) error {
+	processor, ok := ru.processorsStorage.Remove(processorID)
+	if !ok {
+		return fmt.Errorf("deregistration of processor %d: %w", processorID, domain.ErrUnknownProcessor)
+	}
+	ru.metricsStorage.DeregisteredProcessor(processor.Processor, false)
+	ru.closeConnection(processorID)
+	ru.log.Info("Processor deregistered", zap.Stringer("processor", processor))
+
+	return nil
+}
+
+func (ru *RegistrationUseCase) removeExpired() {
+	for _, processor := range ru.processorsStorage.Expired(ru.clk.Now()) {
+		if _, ok := ru.processorsStorage.Remove(processor.Processor.Id); !ok {
+			continue
+		}
+		ru.metricsStorage.DeregisteredProcessor(processor.Processor, true)
+		ru.closeConnection(processor.Processor.Id)
+		ru.log.Warn("Processor lease expired, deregistered", zap.Stringer("processor", processor))
+	}
+}
+
+func connectProcessor(host string) (*clients.ProcessorClient, closeConnection, error) {
 	conn, err := grpc.NewClient(host, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create grpc client: %w", err)
+		return nil, nil, fmt.Errorf("failed to create grpc client: %w", err)
 	}
-	ru.saveConnection(conn.Close)
 	client := incedent_processor.NewIncedentProcessorClient(conn)
 
-	return clients.NewProcessorClient(client), nil
+	return clients.NewProcessorClient(client), conn.Close, nil
+}
+
+func (ru *RegistrationUseCase) saveConnection(processorID uint64, conn closeConnection) {
+	ru.mu.Lock()
+	defer ru.mu.Unlock()
+
+	if old, ok := ru.connections[processorID]; ok {
+		if err := old(); err != nil {
+			ru.log.Error("Failed to close connection", zap.Error(err))
+		}
+	}
+	ru.connections[processorID] = conn
+}
+
+func (ru *RegistrationUseCase) closeConnection(processorID uint64) {
+	ru.mu.Lock()
+	defer ru.mu.Unlock()
+
+	conn, ok := ru.connections[processorID]
+	if !ok {
+		return
+	}
+	if err := conn(); err != nil {
+		ru.log.Error("Failed to close connection", zap.Error(err))
+	}
+	delete(ru.connections, processorID)
+}
+
+func (ru *RegistrationUseCase) setRetired(processorID uint64, retired bool) {
+	ru.mu.Lock()
+	defer ru.mu.Unlock()
+
+	if retired {
+		ru.retired[processorID] = struct{}{}
+	} else {
+		delete(ru.retired, processorID)
+	}
 }
 
-func (ru *RegistrationUseCase) saveConnection(conn closeConnection) {
+func (ru *RegistrationUseCase) isRetired(processorID uint64) bool {
 	ru.mu.Lock()
 	defer ru.mu.Unlock()
 
-	ru.connections = append(ru.connections, conn)
+	_, ok := ru.retired[processorID]
+	return ok
 }
 
 func (ru *RegistrationUseCase) closeConnections() {
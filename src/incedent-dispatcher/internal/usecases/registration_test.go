@@ -0,0 +1,170 @@
+package usecases
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/clients"
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/repositories"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+var _ = Describe("Registration", func() {
+	const lease = 4 * time.Second
+
+	var (
+		clk          *clock.Mock
+		pStorage     *repositories.ProcessorStorage
+		mStorage     *repositories.MetricsStorage
+		registration *RegistrationUseCase
+		// closed counts closed connections of every processor
+		closed map[uint64]*atomic.Int32
+		cancel context.CancelFunc
+		done   chan struct{}
+	)
+
+	processor := domain.IncedentProcessor{Id: 1, Host: "localhost:0"}
+
+	BeforeEach(func() {
+		log := logger.InitZapWrapper(zap.NewNop())
+		clk = clock.NewMock()
+		strategy, err := repositories.NewSelectionStrategy(repositories.RoundRobinStrategy)
+		Expect(err).To(Succeed())
+		pStorage = repositories.NewProcessorStorage(clk, strategy)
+		mStorage = repositories.NewMetricsStorage(log, clk, domain.Precision{Confidence: 0.95}, domain.WarmUp{})
+		registration = NewRegistrationUseCase(log, clk, pStorage, mStorage, lease)
+		closed = map[uint64]*atomic.Int32{processor.Id: {}}
+		registration.connect = func(host string) (*clients.ProcessorClient, closeConnection, error) {
+			client, closeConn, err := connectProcessor(host)
+			counter := closed[processor.Id]
+			return client, func() error {
+				counter.Add(1)
+				return closeConn()
+			}, err
+		}
+
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		done = make(chan struct{})
+		go func() {
+			defer close(done)
+			Expect(registration.Run(ctx)).To(Succeed())
+		}()
+	})
+
+	AfterEach(func() {
+		cancel()
+		Eventually(done).Should(BeClosed())
+		registration.Stop()
+	})
+
+	// advance moves the clock by lease checks, so that every check runs
+	advance := func(d time.Duration) {
+		for range d / (lease / leaseChecksNumber) {
+			clk.Add(lease / leaseChecksNumber)
+		}
+	}
+
+	// waitExpired moves the clock until the processor is removed, returns time since registration
+	waitExpired := func(since time.Time) time.Duration {
+		Eventually(func() bool {
+			clk.Add(lease / leaseChecksNumber)
+			return pStorage.Has(processor.Id)
+		}).Should(BeFalse())
+
+		return clk.Since(since)
+	}
+
+	It("Expired lease deregisters processor", func() {
+		registered := clk.Now()
+		Expect(registration.Register(context.Background(), processor)).To(Succeed())
+		advance(lease)
+		Expect(pStorage.Has(processor.Id)).To(BeTrue())
+
+		Expect(waitExpired(registered)).To(BeNumerically(">", lease))
+		Expect(closed[processor.Id].Load()).To(Equal(int32(1)))
+		report := mStorage.Report()
+		Expect(report.Processors).To(HaveLen(1))
+		Expect(report.Processors[0].Deregistered).To(BeTrue())
+		Expect(report.Processors[0].LeaseExpired).To(BeTrue())
+		Expect(registration.Heartbeat(context.Background(), processor.Id)).To(MatchError(domain.ErrUnknownProcessor))
+	})
+
+	It("Heartbeat renews lease", func() {
+		Expect(registration.Register(context.Background(), processor)).To(Succeed())
+		for range 3 {
+			advance(lease / 2)
+			Expect(registration.Heartbeat(context.Background(), processor.Id)).To(Succeed())
+		}
+		Consistently(func() bool { return pStorage.Has(processor.Id) }, 50*time.Millisecond).Should(BeTrue())
+		Expect(closed[processor.Id].Load()).To(BeZero())
+
+		// lease ends a lease after the last heartbeat
+		heartbeat := clk.Now()
+		Expect(waitExpired(heartbeat)).To(BeNumerically(">", lease))
+	})
+
+	It("Deregistration closes connection", func() {
+		Expect(registration.Register(context.Background(), processor)).To(Succeed())
+		// registration again replaces connection
+		Expect(registration.Register(context.Background(), processor)).To(Succeed())
+		Expect(closed[processor.Id].Load()).To(Equal(int32(1)))
+
+		Expect(registration.Deregister(context.Background(), processor.Id)).To(Succeed())
+		Expect(closed[processor.Id].Load()).To(Equal(int32(2)))
+		Expect(pStorage.Has(processor.Id)).To(BeFalse())
+		report := mStorage.Report()
+		Expect(report.Processors[0].Deregistered).To(BeTrue())
+		Expect(report.Processors[0].LeaseExpired).To(BeFalse())
+
+		Expect(registration.Deregister(context.Background(), processor.Id)).To(MatchError(domain.ErrUnknownProcessor))
+		Expect(registration.Heartbeat(context.Background(), processor.Id)).To(MatchError(domain.ErrUnknownProcessor))
+		// lease of deregistered processor doesn't expire
+		advance(2 * lease)
+		Consistently(closed[processor.Id].Load, 50*time.Millisecond).Should(Equal(int32(2)))
+	})
+
+	It("Deregistration waits for incedents in flight", func() {
+		Expect(registration.Register(context.Background(), processor)).To(Succeed())
+		_, ok := pStorage.Acquire()
+		Expect(ok).To(BeTrue())
+
+		deregistered := make(chan error, 1)
+		go func() {
+			deregistered <- registration.Deregister(context.Background(), processor.Id)
+		}()
+		Eventually(func() bool {
+			_, ok := pStorage.Acquire()
+			return ok
+		}).Should(BeFalse())
+		Consistently(deregistered, 50*time.Millisecond).ShouldNot(Receive())
+		Expect(closed[processor.Id].Load()).To(BeZero())
+
+		pStorage.SetFree(processor.Id)
+		Eventually(deregistered).Should(Receive(Succeed()))
+		Expect(closed[processor.Id].Load()).To(Equal(int32(1)))
+		Expect(pStorage.Has(processor.Id)).To(BeFalse())
+	})
+
+	It("Deregistration leaves processor draining when it is cancelled", func() {
+		Expect(registration.Register(context.Background(), processor)).To(Succeed())
+		_, ok := pStorage.Acquire()
+		Expect(ok).To(BeTrue())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		Expect(registration.Deregister(ctx, processor.Id)).To(MatchError(context.Canceled))
+		Expect(pStorage.Has(processor.Id)).To(BeTrue())
+		Expect(pStorage.Drained()).To(BeEmpty())
+		pStorage.SetFree(processor.Id)
+		Expect(pStorage.Drained()).To(HaveLen(1))
+		Expect(closed[processor.Id].Load()).To(BeZero())
+	})
+})
@@ -0,0 +1,70 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+// ReportUseCase writes statistics report on SIGUSR1 and at shutdown
+type ReportUseCase struct {
+	log            *logger.Logger
+	metricsStorage metricsStorage
+	writer         reportWriter
+
+	mu sync.Mutex
+}
+
+func NewReportUseCase(
+	log *logger.Logger,
+	metricsStorage metricsStorage,
+	writer reportWriter,
+) *ReportUseCase {
+	return &ReportUseCase{
+		log:            log,
+		metricsStorage: metricsStorage,
+		writer:         writer,
+	}
+}
+
+func (ru *ReportUseCase) Run(ctx context.Context) error {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGUSR1)
+	defer signal.Stop(signals)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-signals:
+			if err := ru.WriteReport(); err != nil {
+				ru.log.Error("Failed to write report", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (ru *ReportUseCase) Stop() {
+	if err := ru.WriteReport(); err != nil {
+		ru.log.Error("Failed to write report", zap.Error(err))
+	}
+}
+
+func (ru *ReportUseCase) WriteReport() error {
+	ru.mu.Lock()
+	defer ru.mu.Unlock()
+
+	if err := ru.writer.Write(ru.metricsStorage.Report()); err != nil {
+		return fmt.Errorf("report: %w", err)
+	}
+	ru.log.Info("Report written")
+
+	return nil
+}
@@ -0,0 +1,491 @@
+package usecases
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+	"github.com/PonomarevAlexxander/queuing-system/utils/scheduler"
+)
+
+// VirtualClock is mock clock moved by simulation from one event to another.
+// clock.Mock sleeps for a millisecond on every move to let other goroutines run,
+// so current time is kept aside and timers of the embedded mock don't fire:
+// simulated processors don't fail, so dispatcher doesn't wait for retries.
+type VirtualClock struct {
+	*clock.Mock
+
+	mu  sync.RWMutex
+	now time.Time
+}
+
+func NewVirtualClock() *VirtualClock {
+	mock := clock.NewMock()
+	return &VirtualClock{
+		Mock: mock,
+		now:  mock.Now(),
+	}
+}
+
+func (vc *VirtualClock) Now() time.Time {
+	vc.mu.RLock()
+	defer vc.mu.RUnlock()
+
+	return vc.now
+}
+
+func (vc *VirtualClock) Since(t time.Time) time.Duration {
+	return vc.Now().Sub(t)
+}
+
+func (vc *VirtualClock) Until(t time.Time) time.Duration {
+	return t.Sub(vc.Now())
+}
+
+func (vc *VirtualClock) set(t time.Time) {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+
+	vc.now = t
+}
+
+// SimulatedProducer generates incedents of one priority
+type SimulatedProducer struct {
+	Priority domain.Priority
+	Arrivals scheduler.BackoffGetter
+}
+
+// SimulatedProcessor serves incedents for sampled time
+type SimulatedProcessor struct {
+	Processor   domain.IncedentProcessor
+	ServiceTime scheduler.BackoffGetter
+}
+
+type simulationEvent struct {
+	at     time.Time
+	seq    uint64
+	handle func()
+}
+
+// eventQueue is a min-heap of events, events at the same time are handled in scheduling order
+type eventQueue []simulationEvent
+
+func (q eventQueue) Len() int { return len(q) }
+func (q eventQueue) Less(i, j int) bool {
+	if q[i].at.Equal(q[j].at) {
+		return q[i].seq < q[j].seq
+	}
+	return q[i].at.Before(q[j].at)
+}
+func (q eventQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *eventQueue) Push(x any)   { *q = append(*q, x.(simulationEvent)) }
+func (q *eventQueue) Pop() any {
+	old := *q
+	n := len(old)
+	event := old[n-1]
+	*q = old[:n-1]
+	return event
+}
+
+// dispatcherState is what processing goroutine of the dispatcher waits for
+type dispatcherState int
+
+const (
+	dispatcherRunning dispatcherState = iota
+	dispatcherWaitsIncedents
+	dispatcherWaitsProcessor
+	dispatcherWaitsPacket
+)
+
+// Simulation runs IncedentDispatcher with its buffer and processor storages in
+// virtual time. Producers and processors are in-process: incedents are submitted
+// at sampled arrivals and processors answer after sampled service time, they
+// don't fail, so incedents aren't retried. Goroutines of the dispatcher are
+// tracked by wrappers of the storages and time is moved to the next event only
+// when all of them wait, so an hour of traffic takes seconds and runs with
+// fixed seeds are reproducible.
+type Simulation struct {
+	log            *logger.Logger
+	clk            *VirtualClock
+	dispatcher     *IncedentDispatcher
+	pStorage       processorsStorage
+	metricsStorage metricsStorage
+
+	producers    []SimulatedProducer
+	processors   []SimulatedProcessor
+	serviceTimes map[uint64]scheduler.BackoffGetter
+	duration     time.Duration
+	maxIncedents int
+
+	start     time.Time
+	handled   int
+	generated int
+	// counters are per priority, dispatcher tells incedents by id and priority
+	counters  map[domain.Priority]uint64
+	submitted sync.WaitGroup
+
+	// mu guards events and state of dispatcher goroutines
+	mu      sync.Mutex
+	settled *sync.Cond
+	events  eventQueue
+	lastSeq uint64
+	// running is number of goroutines which haven't waited for the next event yet
+	running int
+	state   dispatcherState
+	// packet keeps incedents of the current packet waiting for processor
+	packet []domain.Incedent
+	// sending is number of incedents of the current packet which aren't finished
+	sending int
+	// serving are closed when processors finish incedents
+	serving map[incedentInfo]chan struct{}
+
+	stopped  chan struct{}
+	stopOnce sync.Once
+}
+
+func NewSimulation(
+	log *logger.Logger,
+	clk *VirtualClock,
+	bStorage bufferStorage,
+	pStorage processorsStorage,
+	metricsStorage metricsStorage,
+	journal eventJournal,
+	producers []SimulatedProducer,
+	processors []SimulatedProcessor,
+	duration time.Duration,
+	maxIncedents int,
+) *Simulation {
+	serviceTimes := make(map[uint64]scheduler.BackoffGetter, len(processors))
+	for _, processor := range processors {
+		serviceTimes[processor.Processor.Id] = processor.ServiceTime
+	}
+
+	s := &Simulation{
+		log:            log,
+		clk:            clk,
+		pStorage:       pStorage,
+		metricsStorage: metricsStorage,
+		producers:      producers,
+		processors:     processors,
+		serviceTimes:   serviceTimes,
+		duration:       duration,
+		maxIncedents:   maxIncedents,
+		counters:       make(map[domain.Priority]uint64),
+		serving:        make(map[incedentInfo]chan struct{}),
+		stopped:        make(chan struct{}),
+	}
+	s.settled = sync.NewCond(&s.mu)
+	s.dispatcher = NewIncedentDispatcher(
+		dispatcherLog(log), clk,
+		simulatedBuffer{bufferStorage: bStorage, s: s},
+		simulatedProcessors{processorsStorage: pStorage, s: s},
+		metricsStorage, journal,
+		domain.RetryPolicy{MaxAttempts: 1},
+	)
+
+	return s
+}
+
+// dispatcherLog keeps log of the simulation readable, dispatcher logs
+// every incedent at info level, they are written only at debug level
+func dispatcherLog(log *logger.Logger) *logger.Logger {
+	if log.Core().Enabled(zapcore.DebugLevel) || !log.Core().Enabled(zapcore.InfoLevel) {
+		return log
+	}
+
+	return logger.InitZapWrapper(log.WithOptions(zap.IncreaseLevel(zapcore.WarnLevel)))
+}
+
+// Run generates incedents until simulation duration is over or number of
+// incedents is reached, then incedents left in the dispatcher are processed.
+// Zero duration and limit mean no limit, then the run lasts until it is stopped.
+func (s *Simulation) Run(ctx context.Context) error {
+	s.start = s.clk.Now()
+	for _, processor := range s.processors {
+		s.metricsStorage.RegisteredProcessor(processor.Processor)
+		s.pStorage.Add(domain.ProcessorClientInfo{
+			Processor: processor.Processor,
+			Client:    simulatedClient{s: s},
+		})
+	}
+	s.mu.Lock()
+	for i := range s.producers {
+		s.scheduleArrival(ctx, s.start, i)
+	}
+	// dispatcher runs until it waits for the first incedent
+	s.running++
+	s.mu.Unlock()
+
+	dispatcherCtx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := s.dispatcher.Run(dispatcherCtx); err != nil {
+			s.log.Error("Dispatcher failed", zap.Error(err))
+		}
+	}()
+
+	for event, ok := s.nextEvent(); ok; event, ok = s.nextEvent() {
+		s.clk.set(event.at)
+		event.handle()
+		s.handled++
+	}
+	// all incedents are finished, so dispatcher stops right away
+	cancel()
+	<-done
+	s.submitted.Wait()
+	s.log.Info(
+		"Simulation finished",
+		zap.Duration("virtual time", s.clk.Since(s.start)),
+		zap.Int("events", s.handled),
+		zap.Int("incedents", s.generated),
+	)
+
+	return nil
+}
+
+func (s *Simulation) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopped)
+	})
+}
+
+func (s *Simulation) isStopped(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	case <-s.stopped:
+		return true
+	default:
+		return false
+	}
+}
+
+// nextEvent waits until goroutines of the dispatcher handle the previous event
+func (s *Simulation) nextEvent() (simulationEvent, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for s.running > 0 {
+		s.settled.Wait()
+	}
+	if s.events.Len() == 0 {
+		return simulationEvent{}, false
+	}
+
+	return heap.Pop(&s.events).(simulationEvent), true
+}
+
+// schedule must be called with mu held
+func (s *Simulation) schedule(at time.Time, handle func()) {
+	s.lastSeq++
+	heap.Push(&s.events, simulationEvent{
+		at:     at,
+		seq:    s.lastSeq,
+		handle: handle,
+	})
+}
+
+// scheduleArrival plans the next incedent of the producer, incedents
+// aren't generated after the duration, mu must be held
+func (s *Simulation) scheduleArrival(ctx context.Context, now time.Time, producer int) {
+	at := now.Add(max(s.producers[producer].Arrivals.NextInterval(), 0))
+	if s.duration > 0 && at.Sub(s.start) > s.duration {
+		return
+	}
+	s.schedule(at, func() {
+		s.arrive(ctx, producer)
+	})
+}
+
+// arrive submits incedent to the dispatcher, submitter waits for the result in its goroutine
+func (s *Simulation) arrive(ctx context.Context, producer int) {
+	if s.isStopped(ctx) || s.maxIncedents > 0 && s.generated >= s.maxIncedents {
+		return
+	}
+	s.generated++
+	priority := s.producers[producer].Priority
+	s.counters[priority]++
+	incedent := domain.Incedent{
+		Id:           s.counters[priority],
+		CreationTime: s.clk.Now(),
+		Priority:     priority,
+	}
+
+	s.mu.Lock()
+	s.scheduleArrival(ctx, incedent.CreationTime, producer)
+	s.running++
+	s.mu.Unlock()
+
+	s.submitted.Add(1)
+	go func() {
+		defer s.submitted.Done()
+		// result is counted by the dispatcher
+		_ = s.dispatcher.NewIncedent(ctx, incedent)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.park()
+	}()
+}
+
+// startService plans the end of processing, mu must be held
+func (s *Simulation) startService(incedent domain.Incedent, processor domain.ProcessorClientInfo) {
+	served := make(chan struct{})
+	s.serving[incedentInfo{id: incedent.Id, priority: incedent.Priority}] = served
+	at := s.clk.Now().Add(max(s.serviceTimes[processor.Processor.Id].NextInterval(), 0))
+	s.schedule(at, func() {
+		s.mu.Lock()
+		s.running++
+		s.mu.Unlock()
+		close(served)
+	})
+}
+
+// park marks goroutine which waits for the next event, mu must be held
+func (s *Simulation) park() {
+	s.running--
+	if s.running == 0 {
+		s.settled.Signal()
+	}
+}
+
+// wait parks processing goroutine of the dispatcher, mu must be held
+func (s *Simulation) wait(state dispatcherState) {
+	s.state = state
+	s.park()
+}
+
+// wake resumes processing goroutine if it waits for the state, mu must be held
+func (s *Simulation) wake(state dispatcherState) {
+	if s.state != state {
+		return
+	}
+	s.state = dispatcherRunning
+	s.running++
+}
+
+// simulatedBuffer tracks processing goroutine waiting for incedents
+// and submitters waiting for results
+type simulatedBuffer struct {
+	bufferStorage
+	s *Simulation
+}
+
+func (b simulatedBuffer) CheckAndPut(incedent domain.Incedent) error {
+	b.s.mu.Lock()
+	defer b.s.mu.Unlock()
+
+	if err := b.bufferStorage.CheckAndPut(incedent); err != nil {
+		return err
+	}
+	b.s.park()
+	b.s.wake(dispatcherWaitsIncedents)
+
+	return nil
+}
+
+// EvictAndPut parks the submitter and resumes submitter of the evicted incedent,
+// it is the same one when the new incedent isn't admitted
+func (b simulatedBuffer) EvictAndPut(incedent domain.Incedent) domain.Incedent {
+	b.s.mu.Lock()
+	defer b.s.mu.Unlock()
+
+	evicted := b.bufferStorage.EvictAndPut(incedent)
+	if evicted.Id != incedent.Id || evicted.Priority != incedent.Priority {
+		b.s.wake(dispatcherWaitsIncedents)
+	}
+
+	return evicted
+}
+
+func (b simulatedBuffer) GetPacket() []domain.Incedent {
+	b.s.mu.Lock()
+	defer b.s.mu.Unlock()
+
+	packet := b.bufferStorage.GetPacket()
+	if len(packet) == 0 {
+		b.s.wait(dispatcherWaitsIncedents)
+		return packet
+	}
+	b.s.packet = packet
+
+	return packet
+}
+
+// DeleteIncedent ends sender of the incedent and resumes its submitter
+func (b simulatedBuffer) DeleteIncedent(incedent domain.Incedent) error {
+	b.s.mu.Lock()
+	defer b.s.mu.Unlock()
+
+	err := b.bufferStorage.DeleteIncedent(incedent)
+	b.s.sending--
+	if b.s.sending == 0 && len(b.s.packet) == 0 {
+		b.s.wake(dispatcherWaitsPacket)
+	}
+
+	return err
+}
+
+// simulatedProcessors starts service of incedents of the packet in order they are sent
+type simulatedProcessors struct {
+	processorsStorage
+	s *Simulation
+}
+
+func (p simulatedProcessors) Acquire() (domain.ProcessorClientInfo, bool) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+
+	processor, ok := p.processorsStorage.Acquire()
+	if !ok {
+		p.s.wait(dispatcherWaitsProcessor)
+		return processor, false
+	}
+	// service time is sampled here, so it doesn't depend on order of senders
+	p.s.startService(p.s.packet[0], processor)
+	p.s.packet = p.s.packet[1:]
+	p.s.sending++
+	p.s.running++
+	if len(p.s.packet) == 0 {
+		p.s.wait(dispatcherWaitsPacket)
+	}
+
+	return processor, true
+}
+
+func (p simulatedProcessors) SetFree(processorID uint64) {
+	p.s.mu.Lock()
+	defer p.s.mu.Unlock()
+
+	p.processorsStorage.SetFree(processorID)
+	p.s.wake(dispatcherWaitsProcessor)
+}
+
+// simulatedClient answers when service time of the incedent is over
+type simulatedClient struct {
+	s *Simulation
+}
+
+func (c simulatedClient) SendIncedent(ctx context.Context, incedent domain.Incedent) error {
+	c.s.mu.Lock()
+	key := incedentInfo{id: incedent.Id, priority: incedent.Priority}
+	served := c.s.serving[key]
+	delete(c.s.serving, key)
+	c.s.park()
+	c.s.mu.Unlock()
+
+	select {
+	case <-served:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
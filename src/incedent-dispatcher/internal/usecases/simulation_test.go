@@ -0,0 +1,172 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/repositories"
+	"github.com/PonomarevAlexxander/queuing-system/utils/config"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+	"github.com/PonomarevAlexxander/queuing-system/utils/scheduler"
+)
+
+func TestUsecases(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Usecases Suite")
+}
+
+func runSimulation(
+	bufferCapacity uint64,
+	producers []SimulatedProducer,
+	processors []SimulatedProcessor,
+	duration time.Duration,
+	journalCapacity int,
+) (domain.Report, *repositories.Journal) {
+	log := logger.InitZapWrapper(zap.NewNop())
+	clk := NewVirtualClock()
+	var journal *repositories.Journal
+	if journalCapacity > 0 {
+		journal = repositories.NewJournal(clk, journalCapacity)
+	}
+	policy, err := repositories.NewEvictionPolicy(repositories.LowerPriorityPolicy)
+	Expect(err).To(Succeed())
+	strategy, err := repositories.NewSelectionStrategy(repositories.RoundRobinStrategy)
+	Expect(err).To(Succeed())
+	mStorage := repositories.NewMetricsStorage(log, clk, domain.Precision{Confidence: 0.95}, domain.WarmUp{})
+
+	simulation := NewSimulation(
+		log, clk,
+		repositories.NewBufferStorage(log, bufferCapacity, policy, nil, journal),
+		repositories.NewProcessorStorage(clk, strategy),
+		mStorage, journal,
+		producers, processors, duration, 0,
+	)
+	Expect(simulation.Run(context.Background())).To(Succeed())
+
+	return mStorage.Report(), journal
+}
+
+func exponential(seed uint64, mean time.Duration) scheduler.BackoffGetter {
+	backoff, err := scheduler.NewDistribution(config.DistributionConfig{
+		Type: scheduler.ExponentialDistribution,
+		Seed: seed,
+	}, mean)
+	Expect(err).To(Succeed())
+
+	return backoff
+}
+
+var _ = Describe("Simulation", func() {
+	It("Handles deterministic flow exactly", func() {
+		report, _ := runSimulation(
+			10,
+			[]SimulatedProducer{{Priority: 1, Arrivals: scheduler.NewLinearBackoff(time.Second)}},
+			[]SimulatedProcessor{{
+				Processor:   domain.IncedentProcessor{Id: 1},
+				ServiceTime: scheduler.NewLinearBackoff(500 * time.Millisecond),
+			}},
+			10*time.Second,
+			0,
+		)
+
+		Expect(report.Priorities).To(HaveLen(1))
+		stats := report.Priorities[0]
+		Expect(stats.Total).To(Equal(10))
+		// the last one arrives at the end of simulation and is processed after it
+		Expect(stats.Processed).To(Equal(10))
+		Expect(stats.InProgress).To(BeZero())
+		Expect(stats.TimeInBuffer.Mean).To(BeZero())
+		Expect(stats.TimeInProcessing.Mean).To(Equal(500 * time.Millisecond))
+
+		Expect(report.Processors).To(HaveLen(1))
+		Expect(report.Processors[0].Uptime).To(Equal(10500 * time.Millisecond))
+		Expect(report.Processors[0].Utilization).To(BeNumerically("~", 5/10.5, 1e-9))
+	})
+
+	It("Rejects lower priority when buffer is full", func() {
+		report, _ := runSimulation(
+			1,
+			[]SimulatedProducer{
+				{Priority: 1, Arrivals: scheduler.NewLinearBackoff(time.Second)},
+				{Priority: 2, Arrivals: scheduler.NewLinearBackoff(time.Second)},
+			},
+			[]SimulatedProcessor{{
+				Processor:   domain.IncedentProcessor{Id: 1},
+				ServiceTime: scheduler.NewLinearBackoff(time.Hour),
+			}},
+			5*time.Second,
+			0,
+		)
+
+		Expect(report.Priorities).To(HaveLen(2))
+		// the first incedent holds the buffer until the end of simulation
+		Expect(report.Priorities[0].Processed + report.Priorities[1].Processed).To(Equal(1))
+		Expect(report.Priorities[0].Rejected).To(BeNumerically(">", 0))
+	})
+
+	It("Same seeds give same statistics", func() {
+		run := func() domain.Report {
+			report, _ := runSimulation(
+				5,
+				[]SimulatedProducer{{Priority: 1, Arrivals: exponential(1, time.Second)}},
+				[]SimulatedProcessor{
+					{Processor: domain.IncedentProcessor{Id: 1}, ServiceTime: exponential(2, 1500*time.Millisecond)},
+					{Processor: domain.IncedentProcessor{Id: 2}, ServiceTime: exponential(3, 1500*time.Millisecond)},
+				},
+				time.Hour,
+				0,
+			)
+			return report
+		}
+
+		first, second := run(), run()
+		Expect(first.Priorities[0].Total).To(BeNumerically("~", 3600, 300))
+		// statistics are summed in map order, so only exact values are compared
+		Expect(second.Processors).To(Equal(first.Processors))
+		Expect(second.Priorities[0].Total).To(Equal(first.Priorities[0].Total))
+		Expect(second.Priorities[0].Rejected).To(Equal(first.Priorities[0].Rejected))
+		Expect(second.Priorities[0].InProgress).To(Equal(first.Priorities[0].InProgress))
+	})
+
+	It("Journals every step", func() {
+		// incedent in processing still occupies the buffer
+		_, journal := runSimulation(
+			2,
+			[]SimulatedProducer{{Priority: 1, Arrivals: scheduler.NewLinearBackoff(time.Second)}},
+			[]SimulatedProcessor{{
+				Processor:   domain.IncedentProcessor{Id: 1},
+				ServiceTime: scheduler.NewLinearBackoff(1500 * time.Millisecond),
+			}},
+			3*time.Second,
+			100,
+		)
+
+		events, _ := journal.Events(0)
+		steps := make([]string, 0, len(events))
+		for _, event := range events {
+			steps = append(steps, fmt.Sprintf("%v %v", event.Type, event.Incedent.Id))
+		}
+		Expect(steps).To(Equal([]string{
+			"arrived 1", "buffered 1", "started 1",
+			"arrived 2", "buffered 2",
+			"finished 1", "started 2",
+			"arrived 3", "buffered 3",
+			// arrivals are over, incedents left are processed
+			"finished 2", "started 3",
+			"finished 3",
+		}))
+		Expect(events[5].Time.Sub(events[0].Time)).To(Equal(1500 * time.Millisecond))
+		buffered := events[8]
+		Expect(buffered.Buffer).To(ConsistOf(HaveField("Id", uint64(3))))
+		Expect(buffered.Processors).To(HaveLen(1))
+		Expect(buffered.Processors[0].Incedents).To(ConsistOf(HaveField("Id", uint64(2))))
+		Expect(events[len(events)-1].Time.Sub(events[0].Time)).To(Equal(4500 * time.Millisecond))
+	})
+})
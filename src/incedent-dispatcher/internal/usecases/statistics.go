@@ -0,0 +1,69 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/benbjohnson/clock"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+const defaultStatisticsInterval = time.Second
+
+// StatisticsUseCase gives access to statistics collected by the dispatcher
+type StatisticsUseCase struct {
+	log            *logger.Logger
+	clk            clock.Clock
+	metricsStorage metricsStorage
+}
+
+func NewStatisticsUseCase(log *logger.Logger, clk clock.Clock, metricsStorage metricsStorage) *StatisticsUseCase {
+	return &StatisticsUseCase{
+		log:            log,
+		clk:            clk,
+		metricsStorage: metricsStorage,
+	}
+}
+
+func (su *StatisticsUseCase) GetStatistics(_ context.Context) domain.Report {
+	return su.metricsStorage.Report()
+}
+
+// WatchStatistics sends report right away and then every interval, zero interval means one second,
+// channel is closed when ctx is done
+func (su *StatisticsUseCase) WatchStatistics(ctx context.Context, interval time.Duration) <-chan domain.Report {
+	if interval <= 0 {
+		interval = defaultStatisticsInterval
+	}
+
+	ch := make(chan domain.Report, 1)
+	ch <- su.metricsStorage.Report()
+	go func() {
+		defer close(ch)
+		ticker := su.clk.Ticker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- su.metricsStorage.Report():
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ResetStatistics starts statistics from now on, known incedents are still tracked
+func (su *StatisticsUseCase) ResetStatistics(_ context.Context) {
+	su.metricsStorage.Reset()
+}
@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/repositories"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+var _ = Describe("Statistics", func() {
+	var (
+		clk        *clock.Mock
+		mStorage   *repositories.MetricsStorage
+		statistics *StatisticsUseCase
+	)
+
+	BeforeEach(func() {
+		log := logger.InitZapWrapper(zap.NewNop())
+		clk = clock.NewMock()
+		mStorage = repositories.NewMetricsStorage(log, clk, domain.Precision{Confidence: 0.95}, domain.WarmUp{})
+		statistics = NewStatisticsUseCase(log, clk, mStorage)
+	})
+
+	It("Doesn't change statistics", func() {
+		mStorage.RegisteredProcessor(domain.IncedentProcessor{Id: 1})
+		mStorage.ReceivedIncedent(domain.Incedent{Id: 1, Priority: 1, CreationTime: clk.Now()})
+		clk.Add(time.Second)
+
+		report := statistics.GetStatistics(context.Background())
+		Expect(report.Priorities).To(HaveLen(1))
+		Expect(report.Priorities[0].InProgress).To(Equal(1))
+		Expect(statistics.GetStatistics(context.Background())).To(Equal(report))
+	})
+
+	It("Watches statistics until cancel", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		reports := statistics.WatchStatistics(ctx, time.Second)
+		Expect((<-reports).Time).To(Equal(clk.Now()))
+
+		mStorage.ReceivedIncedent(domain.Incedent{Id: 1, Priority: 1, CreationTime: clk.Now()})
+		// ticker is created by the watching goroutine
+		Eventually(func() domain.Report {
+			clk.Add(time.Second)
+			select {
+			case report := <-reports:
+				return report
+			default:
+				return domain.Report{}
+			}
+		}).Should(HaveField("Priorities", HaveLen(1)))
+
+		cancel()
+		Eventually(reports).Should(BeClosed())
+	})
+})
@@ -0,0 +1,75 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+// StopRuleUseCase stops the dispatcher when statistics are estimated with configured accuracy
+type StopRuleUseCase struct {
+	log            *logger.Logger
+	clk            clock.Clock
+	metricsStorage metricsStorage
+	checkInterval  time.Duration
+	stop           context.CancelFunc
+}
+
+func NewStopRuleUseCase(
+	log *logger.Logger,
+	clk clock.Clock,
+	metricsStorage metricsStorage,
+	checkInterval time.Duration,
+	stop context.CancelFunc,
+) *StopRuleUseCase {
+	return &StopRuleUseCase{
+		log:            log,
+		clk:            clk,
+		metricsStorage: metricsStorage,
+		checkInterval:  checkInterval,
+		stop:           stop,
+	}
+}
+
+func (su *StopRuleUseCase) Run(ctx context.Context) error {
+	ticker := su.clk.Ticker(su.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if su.accuracyReached() {
+				su.stop()
+				return nil
+			}
+		}
+	}
+}
+
+func (su *StopRuleUseCase) Stop() {}
+
+func (su *StopRuleUseCase) accuracyReached() bool {
+	report := su.metricsStorage.Report()
+	if !report.AccuracyReached() {
+		return false
+	}
+
+	for _, stats := range report.Priorities {
+		su.log.Info("Accuracy reached",
+			zap.Any("priority", stats.Priority),
+			zap.Float64("pRejected", stats.PRejected),
+			zap.Float64("pRejectedHalfWidth", stats.PRejectedInterval.HalfWidth),
+			zap.Float64("timeInSystem", stats.TimeInSystemInterval.Mean),
+			zap.Float64("timeInSystemHalfWidth", stats.TimeInSystemInterval.HalfWidth),
+		)
+	}
+	su.log.Info("Stopping dispatcher", zap.Float64("accuracy", report.Precision.Accuracy))
+
+	return true
+}
@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+// reportStorage returns report set by the test, other methods of metricsStorage aren't used
+type reportStorage struct {
+	metricsStorage
+
+	mu     sync.Mutex
+	report domain.Report
+}
+
+func (rs *reportStorage) Report() domain.Report {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	return rs.report
+}
+
+func (rs *reportStorage) setHalfWidth(halfWidth float64) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	rs.report = domain.Report{
+		Precision: domain.Precision{Confidence: 0.95, Accuracy: 0.1},
+		Priorities: []domain.PriorityReport{{
+			Priority: 1,
+			TimeInSystemInterval: domain.ConfidenceInterval{
+				Mean:      1,
+				HalfWidth: halfWidth,
+				Samples:   domain.ConfidenceBatches,
+			},
+		}},
+	}
+}
+
+var _ = Describe("StopRule", func() {
+	const checkInterval = time.Second
+
+	It("Stops once accuracy is reached", func() {
+		clk := clock.NewMock()
+		storage := &reportStorage{}
+		storage.setHalfWidth(0.5)
+		var stops atomic.Int32
+		stopRule := NewStopRuleUseCase(logger.InitZapWrapper(zap.NewNop()), clk, storage, checkInterval,
+			func() { stops.Add(1) })
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			Expect(stopRule.Run(ctx)).To(Succeed())
+		}()
+
+		// accuracy isn't reached, checks go on
+		for range 5 {
+			clk.Add(checkInterval)
+		}
+		Consistently(done, 50*time.Millisecond).ShouldNot(BeClosed())
+		Expect(stops.Load()).To(BeZero())
+
+		storage.setHalfWidth(0.1)
+		Eventually(func() chan struct{} {
+			clk.Add(checkInterval)
+			return done
+		}).Should(BeClosed())
+		Expect(stops.Load()).To(Equal(int32(1)))
+	})
+
+	It("Doesn't stop on cancel", func() {
+		clk := clock.NewMock()
+		storage := &reportStorage{}
+		storage.setHalfWidth(0.1)
+		var stops atomic.Int32
+		stopRule := NewStopRuleUseCase(logger.InitZapWrapper(zap.NewNop()), clk, storage, checkInterval,
+			func() { stops.Add(1) })
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		Expect(stopRule.Run(ctx)).To(Succeed())
+		Expect(stops.Load()).To(BeZero())
+	})
+})
@@ -0,0 +1,161 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+var errSweepStopped = errors.New("sweep is stopped")
+
+// pointRunner simulates the point with given number of incedents
+type pointRunner func(ctx context.Context, point domain.SweepPoint, incedents int) (domain.Report, error)
+
+// Sweep simulates every point, number of incedents is increased until
+// rejection probability is known with the accuracy of the stop rule.
+// Points are run by Simulation on the dispatcher with in-process producers and processors.
+type Sweep struct {
+	log       *logger.Logger
+	run       pointRunner
+	writer    sweepWriter
+	points    []domain.SweepPoint
+	stopRule  domain.StopRule
+	threshold float64
+
+	stopped  chan struct{}
+	stopOnce sync.Once
+}
+
+func NewSweep(
+	log *logger.Logger,
+	run pointRunner,
+	writer sweepWriter,
+	points []domain.SweepPoint,
+	stopRule domain.StopRule,
+	threshold float64,
+) *Sweep {
+	return &Sweep{
+		log:       log,
+		run:       run,
+		writer:    writer,
+		points:    points,
+		stopRule:  stopRule,
+		threshold: threshold,
+		stopped:   make(chan struct{}),
+	}
+}
+
+func (s *Sweep) Run(ctx context.Context) error {
+	results := make([]domain.SweepResult, 0, len(s.points))
+	for _, point := range s.points {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stopped:
+			return nil
+		default:
+		}
+
+		result, err := s.runPoint(ctx, point)
+		if errors.Is(err, errSweepStopped) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("sweep %s: %w", point, err)
+		}
+		s.log.Info(
+			"Point simulated",
+			zap.Stringer("point", point),
+			zap.Int("incedents", result.Summary.Total),
+			zap.Float64("pRejected", result.Summary.PRejected),
+			zap.Stringer("timeInSystem", result.Summary.TimeInSystem),
+			zap.Float64("utilization", result.Summary.Utilization),
+		)
+		results = append(results, result)
+	}
+
+	for _, result := range minProcessors(results) {
+		s.log.Info(
+			"Minimum processors meeting rejection threshold",
+			zap.Float64("threshold", s.threshold),
+			zap.Stringer("point", result.Point),
+			zap.Float64("pRejected", result.Summary.PRejected),
+		)
+	}
+	if err := s.writer.Write(results); err != nil {
+		return fmt.Errorf("sweep results: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Sweep) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopped)
+	})
+}
+
+// runPoint reruns the point with required number of incedents until
+// the previous run was long enough, a run can be long, so stop is checked between them
+func (s *Sweep) runPoint(ctx context.Context, point domain.SweepPoint) (domain.SweepResult, error) {
+	incedents := s.stopRule.Incedents
+	for {
+		select {
+		case <-ctx.Done():
+			return domain.SweepResult{}, errSweepStopped
+		case <-s.stopped:
+			return domain.SweepResult{}, errSweepStopped
+		default:
+		}
+
+		report, err := s.run(ctx, point, incedents)
+		if err != nil {
+			return domain.SweepResult{}, err
+		}
+		summary := report.Summary()
+		required := s.stopRule.Required(summary.PRejected)
+		if required <= incedents {
+			return domain.SweepResult{
+				Point:          point,
+				Summary:        summary,
+				MeetsThreshold: summary.PRejected <= s.threshold,
+			}, nil
+		}
+
+		s.log.Debug(
+			"Point needs more incedents",
+			zap.Stringer("point", point),
+			zap.Float64("pRejected", summary.PRejected),
+			zap.Int("required", required),
+		)
+		incedents = required
+	}
+}
+
+// minProcessors returns the first point meeting threshold of every
+// buffer capacity and producer interval, points are ordered by number of processors
+func minProcessors(results []domain.SweepResult) []domain.SweepResult {
+	type group struct {
+		capacity uint64
+		interval int64
+	}
+
+	found := make(map[group]bool)
+	minimums := make([]domain.SweepResult, 0)
+	for _, result := range results {
+		key := group{result.Point.BufferCapacity, int64(result.Point.ProducerInterval)}
+		if !result.MeetsThreshold || found[key] {
+			continue
+		}
+		found[key] = true
+		minimums = append(minimums, result)
+	}
+
+	return minimums
+}
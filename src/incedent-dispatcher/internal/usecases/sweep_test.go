@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-dispatcher/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+type memorySweepWriter struct {
+	results []domain.SweepResult
+}
+
+func (w *memorySweepWriter) Write(results []domain.SweepResult) error {
+	w.results = results
+	return nil
+}
+
+// reportWithRejection makes report of incedents with given number of rejected ones
+func reportWithRejection(incedents, rejected int) domain.Report {
+	return domain.Report{Priorities: []domain.PriorityReport{{
+		Total:     incedents,
+		Processed: incedents - rejected,
+		Rejected:  rejected,
+	}}}
+}
+
+var _ = Describe("Sweep", func() {
+	var (
+		writer *memorySweepWriter
+		runs   []int
+	)
+
+	BeforeEach(func() {
+		writer = &memorySweepWriter{}
+		runs = nil
+	})
+
+	newSweep := func(points []domain.SweepPoint, stopRule domain.StopRule) *Sweep {
+		// rejection probability is 0.5 / processors rounded up
+		run := func(_ context.Context, point domain.SweepPoint, incedents int) (domain.Report, error) {
+			runs = append(runs, incedents)
+			return reportWithRejection(incedents, (incedents+2*point.Processors-1)/(2*point.Processors)), nil
+		}
+
+		return NewSweep(logger.InitZapWrapper(zap.NewNop()), run, writer, points, stopRule, 0.2)
+	}
+
+	It("Reruns point until accuracy is reached", func() {
+		sweep := newSweep(
+			[]domain.SweepPoint{{Processors: 1}},
+			domain.StopRule{Incedents: 100, Confidence: 0.95, Accuracy: 0.1},
+		)
+		Expect(sweep.Run(context.Background())).To(Succeed())
+
+		// N = 1.96² * 0.5 / (0.5 * 0.1²)
+		Expect(runs).To(Equal([]int{100, 385}))
+		Expect(writer.results).To(HaveLen(1))
+		Expect(writer.results[0].Summary.Total).To(Equal(385))
+	})
+
+	It("Stops reruns at max incedents", func() {
+		sweep := newSweep(
+			[]domain.SweepPoint{{Processors: 1}},
+			domain.StopRule{Incedents: 100, Confidence: 0.95, Accuracy: 0.01, MaxIncedents: 1000},
+		)
+		Expect(sweep.Run(context.Background())).To(Succeed())
+
+		Expect(runs).To(Equal([]int{100, 1000}))
+		Expect(writer.results[0].Summary.Total).To(Equal(1000))
+	})
+
+	It("Stops between reruns", func() {
+		var sweep *Sweep
+		sweep = NewSweep(logger.InitZapWrapper(zap.NewNop()),
+			func(_ context.Context, _ domain.SweepPoint, incedents int) (domain.Report, error) {
+				runs = append(runs, incedents)
+				sweep.Stop()
+				return reportWithRejection(incedents, incedents/2), nil
+			},
+			writer, []domain.SweepPoint{{Processors: 1}, {Processors: 2}},
+			domain.StopRule{Incedents: 100, Confidence: 0.95, Accuracy: 0.01},
+			0.2,
+		)
+		Expect(sweep.Run(context.Background())).To(Succeed())
+
+		Expect(runs).To(Equal([]int{100}))
+		Expect(writer.results).To(BeEmpty())
+	})
+
+	It("Marks points meeting threshold", func() {
+		points := []domain.SweepPoint{{Processors: 1}, {Processors: 2}, {Processors: 3}, {Processors: 4}}
+		sweep := newSweep(points, domain.StopRule{Incedents: 120})
+		Expect(sweep.Run(context.Background())).To(Succeed())
+
+		Expect(runs).To(Equal([]int{120, 120, 120, 120}))
+		meets := make([]bool, 0, len(points))
+		for _, result := range writer.results {
+			meets = append(meets, result.MeetsThreshold)
+		}
+		Expect(meets).To(Equal([]bool{false, false, true, true}))
+		Expect(minProcessors(writer.results)).To(ConsistOf(writer.results[2]))
+	})
+})
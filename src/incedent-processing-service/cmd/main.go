@@ -63,16 +63,22 @@ func main() {
 	regClient := clients.NewRegisterClient(client)
 
 	clk := clock.New()
-	processingUC := usecases.NewIncedentProcessingUseCase(log, clk,
-		scheduler.NewExponentialBackoff(cfg.InnerConfig.GetInterval()))
+	serviceTime, err := scheduler.NewDistribution(cfg.InnerConfig.ServiceTime, cfg.InnerConfig.GetInterval())
+	if err != nil {
+		log.Fatal("Failed to create service time distribution", zap.Error(err))
+	}
+	processingUC := usecases.NewIncedentProcessingUseCase(log, clk, serviceTime, cfg.InnerConfig.GetCapacity())
 	registerUC := usecases.NewRegisterUseCase(
 		log,
 		clk,
 		domain.RegistrationInfo{
-			Id:   args.Id,
-			Host: args.Host,
+			Id:       args.Id,
+			Host:     args.Host,
+			Weight:   cfg.InnerConfig.Weight,
+			Capacity: cfg.InnerConfig.GetCapacity(),
 		},
 		regClient,
+		cfg.InnerConfig.GetHeartbeatInterval(),
 	)
 
 	lis, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", config.GetPort(args.Host)))
@@ -31,8 +31,10 @@ func (dc *RegisterClient) Register(ctx context.Context, info domain.Registration
 	defer cancel()
 
 	req := &msgs_dispatcher.ProcessorRegisterReq{
-		Id:   info.Id,
-		Host: info.Host,
+		Id:       info.Id,
+		Host:     info.Host,
+		Weight:   info.Weight,
+		Capacity: info.Capacity,
 	}
 
 	resp, err := dc.grpcClient.RegisterProcessor(ctx, req)
@@ -46,3 +48,46 @@ func (dc *RegisterClient) Register(ctx context.Context, info domain.Registration
 
 	return nil
 }
+
+func (dc *RegisterClient) Heartbeat(ctx context.Context, info domain.RegistrationInfo) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req := &msgs_dispatcher.HeartbeatReq{
+		Id: info.Id,
+	}
+
+	resp, err := dc.grpcClient.Heartbeat(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to send heartbeat with grpc: %w", err)
+	}
+
+	if resp.GetRetired() {
+		return fmt.Errorf("heartbeat wasn't accepted, '%s': %w", resp.Result.Msg, domain.ErrRetired)
+	}
+	if !resp.Result.GetSuccess() {
+		return fmt.Errorf("heartbeat wasn't accepted, '%s': %w", resp.Result.Msg, domain.ErrBadResult)
+	}
+
+	return nil
+}
+
+func (dc *RegisterClient) Deregister(ctx context.Context, info domain.RegistrationInfo) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req := &msgs_dispatcher.ProcessorDeregisterReq{
+		Id: info.Id,
+	}
+
+	resp, err := dc.grpcClient.DeregisterProcessor(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to send deregistration req with grpc: %w", err)
+	}
+
+	if !resp.Result.GetSuccess() {
+		return fmt.Errorf("deregistration wasn't handled, '%s': %w", resp.Result.Msg, domain.ErrBadResult)
+	}
+
+	return nil
+}
@@ -8,6 +8,10 @@ import (
 	common_config "github.com/PonomarevAlexxander/queuing-system/utils/config"
 )
 
+const (
+	defaultHeartbeatInterval = 2 * time.Second
+)
+
 type IncedentProcessorConfig struct {
 	common_config.CommonConfig `yaml:",inline"`
 	InnerConfig                InnerConfig                `yaml:"incedent-processor" validate:"required"`
@@ -15,7 +19,12 @@ type IncedentProcessorConfig struct {
 }
 
 type InnerConfig struct {
-	Interval string `yaml:"interval" validate:"required"`
+	// Interval is mean service time
+	Interval          string                           `yaml:"interval" validate:"required,positive_duration"`
+	ServiceTime       common_config.DistributionConfig `yaml:"service-time"`
+	HeartbeatInterval string                           `yaml:"heartbeat-interval" validate:"omitempty,positive_duration"`
+	Weight            uint32                           `yaml:"weight"`
+	Capacity          uint32                           `yaml:"capacity"`
 }
 
 func (ic InnerConfig) GetInterval() time.Duration {
@@ -27,6 +36,23 @@ func (ic InnerConfig) GetInterval() time.Duration {
 	return interval
 }
 
+func (ic InnerConfig) GetHeartbeatInterval() time.Duration {
+	if ic.HeartbeatInterval == "" {
+		return defaultHeartbeatInterval
+	}
+	interval, err := time.ParseDuration(ic.HeartbeatInterval)
+	if err != nil {
+		panic(err)
+	}
+
+	return interval
+}
+
+// GetCapacity returns number of incedents processed in parallel
+func (ic InnerConfig) GetCapacity() uint32 {
+	return max(ic.Capacity, 1)
+}
+
 func GetPort(host string) int {
 	port, err := strconv.Atoi(strings.Split(host, ":")[1])
 	if err != nil {
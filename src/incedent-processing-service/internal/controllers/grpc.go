@@ -43,6 +43,13 @@ func (gc *GrpcController) NewIncedent(ctx context.Context, req *incedent.NewInce
 			Id:           req.GetId(),
 			Priority:     domain.Priority(req.GetPriority()),
 			CreationTime: req.GetTime().AsTime(),
+			Source:       req.GetSource(),
+			Category:     req.GetCategory(),
+			Labels:       req.GetLabels(),
+			Payload: domain.Payload{
+				ContentType: req.GetPayload().GetContentType(),
+				Data:        req.GetPayload().GetData(),
+			},
 		},
 	); err != nil {
 		resp.Result.Success = false
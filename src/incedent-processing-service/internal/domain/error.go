@@ -4,4 +4,6 @@ import "errors"
 
 var (
 	ErrBadResult = errors.New("response had bad result")
+	// ErrRetired means processor was deregistered by admin and mustn't register again
+	ErrRetired = errors.New("processor is retired by dispatcher")
 )
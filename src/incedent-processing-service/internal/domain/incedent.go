@@ -11,8 +11,24 @@ type Incedent struct {
 	Id           uint64
 	CreationTime time.Time
 	Priority     Priority
+	// Source is id of the producer
+	Source   string
+	Category string
+	Labels   map[string]string
+	Payload  Payload
 }
 
 func (i Incedent) String() string {
-	return fmt.Sprintf("Incedent{%v, %v, %v}", i.Id, i.CreationTime, i.Priority)
+	return fmt.Sprintf("Incedent{%v, %v, %v, %q, %q, %v, %v}",
+		i.Id, i.CreationTime, i.Priority, i.Source, i.Category, i.Labels, i.Payload)
+}
+
+type Payload struct {
+	ContentType string
+	Data        []byte
+}
+
+// String doesn't print data, it can be large and binary
+func (p Payload) String() string {
+	return fmt.Sprintf("Payload{%q, %d bytes}", p.ContentType, len(p.Data))
 }
@@ -1,6 +1,8 @@
 package domain
 
 type RegistrationInfo struct {
-	Id   uint64
-	Host string
+	Id       uint64
+	Host     string
+	Weight   uint32
+	Capacity uint32
 }
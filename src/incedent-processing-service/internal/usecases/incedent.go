@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/benbjohnson/clock"
@@ -16,25 +17,38 @@ type backoffGetter interface {
 }
 
 type incedentProcessingUseCase struct {
-	log     *logger.Logger
-	clk     clock.Clock
+	log *logger.Logger
+	clk clock.Clock
+
+	mu      sync.Mutex
 	backoff backoffGetter
+	// slots limits number of incedents processed in parallel
+	slots chan struct{}
 }
 
 func NewIncedentProcessingUseCase(
 	log *logger.Logger,
 	clk clock.Clock,
 	backoff backoffGetter,
+	capacity uint32,
 ) *incedentProcessingUseCase {
 	return &incedentProcessingUseCase{
 		log:     log,
 		clk:     clk,
 		backoff: backoff,
+		slots:   make(chan struct{}, max(capacity, 1)),
 	}
 }
 
 func (ip *incedentProcessingUseCase) ProcessIncedent(ctx context.Context, incedent domain.Incedent) error {
-	interval := ip.backoff.NextInterval()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case ip.slots <- struct{}{}:
+	}
+	defer func() { <-ip.slots }()
+
+	interval := ip.nextInterval()
 	ip.log.Info(
 		"New incedent received, start processing",
 		zap.Stringer("incedent", incedent),
@@ -49,3 +63,10 @@ func (ip *incedentProcessingUseCase) ProcessIncedent(ctx context.Context, incede
 		return nil
 	}
 }
+
+func (ip *incedentProcessingUseCase) nextInterval() time.Duration {
+	ip.mu.Lock()
+	defer ip.mu.Unlock()
+
+	return ip.backoff.NextInterval()
+}
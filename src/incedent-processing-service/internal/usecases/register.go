@@ -2,7 +2,10 @@ package usecases
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/PonomarevAlexxander/queuing-system/incedent-processing-service/internal/domain"
 	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
@@ -16,32 +19,52 @@ const (
 
 type registerClient interface {
 	Register(ctx context.Context, info domain.RegistrationInfo) error
+	Heartbeat(ctx context.Context, info domain.RegistrationInfo) error
+	Deregister(ctx context.Context, info domain.RegistrationInfo) error
 }
 
 type registerUseCase struct {
-	log     *logger.Logger
-	clk     clock.Clock
-	regInfo domain.RegistrationInfo
-	client  registerClient
+	log               *logger.Logger
+	clk               clock.Clock
+	regInfo           domain.RegistrationInfo
+	client            registerClient
+	heartbeatInterval time.Duration
+	// retired processor was deregistered by admin and doesn't register again
+	retired atomic.Bool
 }
 
 func NewRegisterUseCase(
 	log *logger.Logger, clk clock.Clock,
 	regInfo domain.RegistrationInfo, regClient registerClient,
+	heartbeatInterval time.Duration,
 ) *registerUseCase {
 	return &registerUseCase{
-		log:     log,
-		clk:     clk,
-		regInfo: regInfo,
-		client:  regClient,
+		log:               log,
+		clk:               clk,
+		regInfo:           regInfo,
+		client:            regClient,
+		heartbeatInterval: heartbeatInterval,
 	}
 }
 
 func (r *registerUseCase) Run(ctx context.Context) error {
-	return r.tryRegister(ctx)
+	if err := r.tryRegister(ctx); err != nil {
+		return err
+	}
+
+	return r.sendHeartbeats(ctx)
 }
 
+// Stop deregisters processor, so dispatcher stops sending incedents to it
 func (r *registerUseCase) Stop() {
+	if r.retired.Load() {
+		return
+	}
+	if err := r.client.Deregister(context.Background(), r.regInfo); err != nil {
+		r.log.Error("Failed to deregister", zap.Error(err))
+		return
+	}
+	r.log.Info("Successfully deregistered in dispatcher")
 }
 
 func (r *registerUseCase) tryRegister(ctx context.Context) error {
@@ -56,3 +79,33 @@ func (r *registerUseCase) tryRegister(ctx context.Context) error {
 
 	return fmt.Errorf("failed to register: %w", err)
 }
+
+func (r *registerUseCase) sendHeartbeats(ctx context.Context) error {
+	ticker := r.clk.Ticker(r.heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		err := r.client.Heartbeat(ctx, r.regInfo)
+		switch {
+		case err == nil:
+		case errors.Is(err, domain.ErrRetired):
+			r.retired.Store(true)
+			r.log.Warn("Processor was deregistered by admin, heartbeats are stopped", zap.Error(err))
+			return nil
+		case errors.Is(err, domain.ErrBadResult):
+			// lease expired or dispatcher restarted
+			r.log.Warn("Heartbeat rejected, registering again", zap.Error(err))
+			if err := r.tryRegister(ctx); err != nil {
+				return err
+			}
+		default:
+			r.log.Error("Failed to send heartbeat", zap.Error(err))
+		}
+	}
+}
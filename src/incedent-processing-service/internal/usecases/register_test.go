@@ -0,0 +1,168 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-processing-service/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+func TestUsecases(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Usecases Suite")
+}
+
+type fakeRegisterClient struct {
+	mu            sync.Mutex
+	registrations int
+	heartbeats    int
+	deregistered  int
+	// heartbeatErrs are returned by the next heartbeats
+	heartbeatErrs []error
+}
+
+func (f *fakeRegisterClient) Register(_ context.Context, _ domain.RegistrationInfo) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.registrations++
+	return nil
+}
+
+func (f *fakeRegisterClient) Heartbeat(_ context.Context, _ domain.RegistrationInfo) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.heartbeats++
+	if len(f.heartbeatErrs) == 0 {
+		return nil
+	}
+	err := f.heartbeatErrs[0]
+	f.heartbeatErrs = f.heartbeatErrs[1:]
+	return err
+}
+
+func (f *fakeRegisterClient) Deregister(_ context.Context, _ domain.RegistrationInfo) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.deregistered++
+	return nil
+}
+
+func (f *fakeRegisterClient) calls() (registrations, heartbeats, deregistered int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.registrations, f.heartbeats, f.deregistered
+}
+
+var _ = Describe("Register", func() {
+	const heartbeatInterval = time.Second
+
+	var (
+		clk      *clock.Mock
+		client   *fakeRegisterClient
+		register *registerUseCase
+		cancel   context.CancelFunc
+		done     chan struct{}
+	)
+
+	heartbeats := func() int {
+		_, heartbeats, _ := client.calls()
+		return heartbeats
+	}
+
+	// waitHeartbeats moves the clock until processor sends n heartbeats
+	waitHeartbeats := func(n int) {
+		Eventually(func() int {
+			clk.Add(heartbeatInterval)
+			return heartbeats()
+		}).Should(BeNumerically(">=", n))
+	}
+
+	BeforeEach(func() {
+		clk = clock.NewMock()
+		client = &fakeRegisterClient{}
+	})
+
+	run := func() {
+		register = NewRegisterUseCase(
+			logger.InitZapWrapper(zap.NewNop()), clk,
+			domain.RegistrationInfo{Id: 1, Host: "localhost:0", Capacity: 1},
+			client, heartbeatInterval,
+		)
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		done = make(chan struct{})
+		go func() {
+			defer close(done)
+			Expect(register.Run(ctx)).To(Succeed())
+		}()
+	}
+
+	AfterEach(func() {
+		cancel()
+		Eventually(done).Should(BeClosed())
+	})
+
+	It("Sends heartbeats every interval", func() {
+		run()
+		waitHeartbeats(3)
+
+		registrations, _, _ := client.calls()
+		Expect(registrations).To(Equal(1))
+	})
+
+	It("Registers again when heartbeat is rejected", func() {
+		client.heartbeatErrs = []error{domain.ErrBadResult}
+		run()
+		waitHeartbeats(2)
+
+		registrations, _, _ := client.calls()
+		Expect(registrations).To(Equal(2))
+	})
+
+	It("Keeps sending heartbeats after transport failure", func() {
+		client.heartbeatErrs = []error{errors.New("unavailable")}
+		run()
+		waitHeartbeats(3)
+
+		registrations, _, _ := client.calls()
+		Expect(registrations).To(Equal(1))
+	})
+
+	It("Stops heartbeats of retired processor", func() {
+		client.heartbeatErrs = []error{domain.ErrRetired}
+		run()
+		waitHeartbeats(1)
+
+		Eventually(done).Should(BeClosed())
+		Expect(register.retired.Load()).To(BeTrue())
+		clk.Add(5 * heartbeatInterval)
+		Expect(heartbeats()).To(Equal(1))
+
+		// retired processor isn't registered, so it isn't deregistered too
+		register.Stop()
+		_, _, deregistered := client.calls()
+		Expect(deregistered).To(BeZero())
+	})
+
+	It("Deregisters on stop", func() {
+		run()
+		waitHeartbeats(1)
+
+		register.Stop()
+		_, _, deregistered := client.calls()
+		Expect(deregistered).To(Equal(1))
+	})
+})
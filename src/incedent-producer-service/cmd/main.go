@@ -12,6 +12,7 @@ import (
 	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/clients"
 	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/config"
 	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/repositories"
 	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/usecases"
 	"github.com/PonomarevAlexxander/queuing-system/services/incedent_dispatcher"
 	common_config "github.com/PonomarevAlexxander/queuing-system/utils/config"
@@ -21,8 +22,9 @@ import (
 )
 
 var args struct {
-	Config   string `arg:"required"`
-	Priority uint64 `arg:"required"`
+	Config string `arg:"required"`
+	// Priority is required unless trace is replayed
+	Priority *uint64
 }
 
 func main() {
@@ -57,15 +59,72 @@ func main() {
 	grpcClient := incedent_dispatcher.NewIncedentDispatcherClient(conn)
 	dispatcherClient := clients.NewDispatcherClient(grpcClient)
 	clk := clock.New()
+	template := newIncedentTemplate(cfg.Incedent)
+	if cfg.Trace.Enabled {
+		records, err := repositories.ReadTrace(cfg.Trace.Path)
+		if err != nil {
+			log.Fatal("Failed to read trace", zap.Error(err))
+		}
+		replayer := usecases.NewTraceReplayer(
+			log,
+			clk,
+			dispatcherClient,
+			records,
+			cfg.Trace.GetSpeed(),
+			cfg.Trace.Loop,
+			template,
+		)
+		srvcRunner.Run(ctx, replayer)
+		return
+	}
+	if args.Priority == nil {
+		log.Fatal("Priority is required to generate incedents")
+	}
+
 	schdlrRunner := scheduler.NewScheduler(log, clk)
+	arrivals, err := scheduler.NewDistribution(cfg.InnerConfig.Arrival, cfg.InnerConfig.GetInterval())
+	if err != nil {
+		log.Fatal("Failed to create arrival distribution", zap.Error(err))
+	}
 	producer := usecases.NewIncedentProducer(
 		log,
 		clk,
 		dispatcherClient,
 		schdlrRunner,
-		cfg.InnerConfig.GetInterval(),
-		domain.Priority(args.Priority),
+		arrivals,
+		domain.Priority(*args.Priority),
+		template,
+	)
+	if !cfg.Reload.Enabled {
+		srvcRunner.Run(ctx, producer)
+		return
+	}
+
+	configStorage := repositories.NewConfigStorage()
+	configStorage.SetConfig(cfg)
+	reloader := usecases.NewConfigReloader(
+		log,
+		clk,
+		repositories.NewConfigFile(args.Config),
+		configStorage,
+		producer,
+		cfg.Reload.GetWatchInterval(),
 	)
+	srvcRunner.Run(ctx, producer, reloader)
+}
+
+func newIncedentTemplate(cfg config.IncedentConfig) domain.Incedent {
+	template := domain.Incedent{
+		Source:   cfg.GetSource(),
+		Category: cfg.Category,
+		Labels:   cfg.Labels,
+	}
+	if cfg.Payload.Data != "" {
+		template.Payload = domain.Payload{
+			ContentType: cfg.Payload.ContentType,
+			Data:        []byte(cfg.Payload.Data),
+		}
+	}
 
-	srvcRunner.Run(ctx, producer)
+	return template
 }
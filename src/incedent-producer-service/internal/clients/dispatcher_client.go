@@ -35,6 +35,13 @@ func (dc *DispatcherClient) SendIncedent(ctx context.Context, incedent domain.In
 		Id:       incedent.Id,
 		Time:     timestamppb.New(incedent.CreationTime),
 		Priority: uint64(incedent.Priority),
+		Payload: &msgs_dispatcher.Payload{
+			Data:        incedent.Payload.Data,
+			ContentType: incedent.Payload.ContentType,
+		},
+		Labels:   incedent.Labels,
+		Source:   incedent.Source,
+		Category: incedent.Category,
 	}
 
 	resp, err := dc.grpcClient.NewIncedent(ctx, req)
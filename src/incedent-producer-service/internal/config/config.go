@@ -1,26 +1,105 @@
 package config
 
 import (
+	"fmt"
 	"time"
 
 	common_config "github.com/PonomarevAlexxander/queuing-system/utils/config"
 )
 
+const (
+	defaultWatchInterval = time.Second
+	defaultSource        = "incedent-producer"
+)
+
 type IncedentProducerConfig struct {
 	common_config.CommonConfig `yaml:",inline"`
 	InnerConfig                InnerConfig                `yaml:"incedent-producer" validate:"required"`
 	DispatcherConfig           common_config.ClientConfig `yaml:"dispatcher" validate:"required"`
+	Trace                      TraceConfig                `yaml:"trace"`
+	Reload                     ReloadConfig               `yaml:"reload"`
+	Incedent                   IncedentConfig             `yaml:"incedent"`
 }
 
 type InnerConfig struct {
-	Interval string `yaml:"interval" validate:"required"`
+	// Interval is mean time between incedents
+	Interval string                           `yaml:"interval" validate:"required,positive_duration"`
+	Arrival  common_config.DistributionConfig `yaml:"arrival"`
 }
 
 func (ic InnerConfig) GetInterval() time.Duration {
+	interval, err := ic.ParseInterval()
+	if err != nil {
+		panic(err)
+	}
+
+	return interval
+}
+
+// ParseInterval is GetInterval for configs read at runtime, which mustn't panic
+func (ic InnerConfig) ParseInterval() (time.Duration, error) {
 	interval, err := time.ParseDuration(ic.Interval)
+	if err != nil {
+		return 0, fmt.Errorf("interval: %w", err)
+	}
+
+	return interval, nil
+}
+
+// ReloadConfig enables reload of arrival settings, config file is checked
+// every WatchInterval and on SIGHUP, other settings require restart
+type ReloadConfig struct {
+	Enabled       bool   `yaml:"enabled"`
+	WatchInterval string `yaml:"watch-interval" validate:"omitempty,positive_duration"`
+}
+
+func (rc ReloadConfig) GetWatchInterval() time.Duration {
+	if rc.WatchInterval == "" {
+		return defaultWatchInterval
+	}
+	interval, err := time.ParseDuration(rc.WatchInterval)
 	if err != nil {
 		panic(err)
 	}
 
 	return interval
 }
+
+// TraceConfig enables replay of captured traffic instead of generated one
+type TraceConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Path    string `yaml:"path" validate:"required_if=Enabled true"`
+	// Speed scales time, 10 replays trace ten times faster
+	Speed float64 `yaml:"speed" validate:"omitempty,gt=0"`
+	// Loop replays trace again after its last inter-arrival gap
+	Loop bool `yaml:"loop"`
+}
+
+func (tc TraceConfig) GetSpeed() float64 {
+	if tc.Speed == 0 {
+		return 1
+	}
+
+	return tc.Speed
+}
+
+// IncedentConfig is metadata attached to every sent incedent
+type IncedentConfig struct {
+	Source   string            `yaml:"source"`
+	Category string            `yaml:"category"`
+	Labels   map[string]string `yaml:"labels"`
+	Payload  PayloadConfig     `yaml:"payload"`
+}
+
+func (ic IncedentConfig) GetSource() string {
+	if ic.Source == "" {
+		return defaultSource
+	}
+
+	return ic.Source
+}
+
+type PayloadConfig struct {
+	ContentType string `yaml:"content-type" validate:"required_with=Data"`
+	Data        string `yaml:"data"`
+}
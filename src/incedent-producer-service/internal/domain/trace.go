@@ -0,0 +1,12 @@
+package domain
+
+import (
+	"time"
+)
+
+// TraceRecord is incedent captured in the trace, offset is relative to the trace start
+type TraceRecord struct {
+	Offset   time.Duration
+	Priority Priority
+	Id       uint64
+}
@@ -0,0 +1,52 @@
+package repositories
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/config"
+	common_config "github.com/PonomarevAlexxander/queuing-system/utils/config"
+)
+
+// ConfigFile reads producer config and tracks changes of the file
+type ConfigFile struct {
+	path    string
+	modTime time.Time
+	size    int64
+}
+
+func NewConfigFile(path string) *ConfigFile {
+	cf := &ConfigFile{path: path}
+	// file read on startup isn't considered changed
+	cf.Changed()
+
+	return cf
+}
+
+// Changed reports whether file was modified since previous call
+func (cf *ConfigFile) Changed() bool {
+	info, err := os.Stat(cf.path)
+	if err != nil {
+		// missing file is reported by Load
+		return false
+	}
+	changed := !info.ModTime().Equal(cf.modTime) || info.Size() != cf.size
+	cf.modTime = info.ModTime()
+	cf.size = info.Size()
+
+	return changed
+}
+
+// Load reads and validates config
+func (cf *ConfigFile) Load() (*config.IncedentProducerConfig, error) {
+	cfg, err := common_config.ReadConfigFromYAML[config.IncedentProducerConfig](cf.path)
+	if err != nil {
+		return nil, err
+	}
+	if err := common_config.ValidateConfig(cfg); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	return cfg, nil
+}
@@ -11,8 +11,8 @@ type ConfigStorage struct {
 	cfg *config.IncedentProducerConfig
 }
 
-func NewConfigStorage() ConfigStorage {
-	return ConfigStorage{}
+func NewConfigStorage() *ConfigStorage {
+	return &ConfigStorage{}
 }
 
 func (c *ConfigStorage) SetConfig(cfg *config.IncedentProducerConfig) {
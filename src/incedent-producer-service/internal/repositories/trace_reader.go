@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/domain"
+)
+
+var errUnknownTraceFormat = errors.New("trace must be .csv or .jsonl file")
+
+// traceLine is a line of jsonl trace, timestamp is in seconds from the trace start
+type traceLine struct {
+	Timestamp float64 `json:"timestamp"`
+	Priority  uint64  `json:"priority"`
+	Id        uint64  `json:"id"`
+}
+
+// ReadTrace reads csv ('timestamp,priority,id' with optional header) or jsonl trace,
+// records are sorted by offset
+func ReadTrace(path string) ([]domain.TraceRecord, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open trace: %w", err)
+	}
+	defer file.Close()
+
+	var records []domain.TraceRecord
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		records, err = readCSVTrace(file)
+	case ".jsonl":
+		records, err = readJSONLTrace(file)
+	default:
+		return nil, errUnknownTraceFormat
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trace %s: %w", path, err)
+	}
+	slices.SortStableFunc(records, func(a, b domain.TraceRecord) int {
+		return cmp.Compare(a.Offset, b.Offset)
+	})
+
+	return records, nil
+}
+
+func readCSVTrace(r io.Reader) ([]domain.TraceRecord, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	records := make([]domain.TraceRecord, 0)
+	for line := 1; ; line++ {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && fields[0] == "timestamp" {
+			continue
+		}
+
+		timestamp, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		priority, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		id, err := strconv.ParseUint(fields[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		records = append(records, newTraceRecord(traceLine{Timestamp: timestamp, Priority: priority, Id: id}))
+	}
+}
+
+func readJSONLTrace(r io.Reader) ([]domain.TraceRecord, error) {
+	scanner := bufio.NewScanner(r)
+	records := make([]domain.TraceRecord, 0)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var record traceLine
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		records = append(records, newTraceRecord(record))
+	}
+
+	return records, scanner.Err()
+}
+
+func newTraceRecord(line traceLine) domain.TraceRecord {
+	return domain.TraceRecord{
+		Offset:   time.Duration(line.Timestamp * float64(time.Second)),
+		Priority: domain.Priority(line.Priority),
+		Id:       line.Id,
+	}
+}
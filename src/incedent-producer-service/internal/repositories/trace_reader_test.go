@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/domain"
+)
+
+func TestRepositories(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Repositories Suite")
+}
+
+var _ = Describe("ReadTrace", func() {
+	expected := []domain.TraceRecord{
+		{Offset: 0, Priority: 2, Id: 1},
+		{Offset: 500 * time.Millisecond, Priority: 1, Id: 2},
+		{Offset: 1500 * time.Millisecond, Priority: 3, Id: 3},
+	}
+
+	writeTrace := func(name, content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), name)
+		Expect(os.WriteFile(path, []byte(content), 0o644)).To(Succeed())
+
+		return path
+	}
+
+	It("Reads csv with header and sorts records", func() {
+		path := writeTrace("trace.csv", "timestamp,priority,id\n1.5,3,3\n0,2,1\n0.5,1,2\n")
+		Expect(ReadTrace(path)).To(Equal(expected))
+	})
+
+	It("Reads jsonl", func() {
+		path := writeTrace("trace.jsonl", `{"timestamp":0,"priority":2,"id":1}
+{"timestamp":0.5,"priority":1,"id":2}
+
+{"timestamp":1.5,"priority":3,"id":3}
+`)
+		Expect(ReadTrace(path)).To(Equal(expected))
+	})
+
+	It("Fails on malformed line", func() {
+		path := writeTrace("trace.csv", "0,2,1\nabc,1,2\n")
+		_, err := ReadTrace(path)
+		Expect(err).To(MatchError(ContainSubstring("line 2")))
+	})
+
+	It("Fails on unknown format", func() {
+		path := writeTrace("trace.txt", "")
+		_, err := ReadTrace(path)
+		Expect(err).To(MatchError(errUnknownTraceFormat))
+	})
+})
@@ -0,0 +1,113 @@
+package usecases
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"reflect"
+	"syscall"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/config"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+	"github.com/PonomarevAlexxander/queuing-system/utils/scheduler"
+)
+
+type configFile interface {
+	Changed() bool
+	Load() (*config.IncedentProducerConfig, error)
+}
+
+type arrivalsSetter interface {
+	SetArrivals(arrivals scheduler.BackoffGetter)
+}
+
+// ConfigReloader applies arrival settings to running producer when config file
+// is changed or SIGHUP is received, invalid config is logged and ignored
+type ConfigReloader struct {
+	log *logger.Logger
+	clk clock.Clock
+
+	file          configFile
+	storage       configStorage
+	producer      arrivalsSetter
+	watchInterval time.Duration
+	signals       chan os.Signal
+}
+
+func NewConfigReloader(
+	log *logger.Logger,
+	clk clock.Clock,
+	file configFile,
+	storage configStorage,
+	producer arrivalsSetter,
+	watchInterval time.Duration,
+) *ConfigReloader {
+	return &ConfigReloader{
+		log:           log,
+		clk:           clk,
+		file:          file,
+		storage:       storage,
+		producer:      producer,
+		watchInterval: watchInterval,
+		signals:       make(chan os.Signal, 1),
+	}
+}
+
+func (cr *ConfigReloader) Run(ctx context.Context) error {
+	signal.Notify(cr.signals, syscall.SIGHUP)
+	defer signal.Stop(cr.signals)
+
+	ticker := cr.clk.Ticker(cr.watchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-cr.signals:
+			cr.log.Info("SIGHUP received, reloading config")
+			cr.file.Changed()
+			cr.reload()
+		case <-ticker.C:
+			if cr.file.Changed() {
+				cr.log.Info("Config file changed, reloading config")
+				cr.reload()
+			}
+		}
+	}
+}
+
+func (cr *ConfigReloader) Stop() {}
+
+func (cr *ConfigReloader) reload() {
+	cfg, err := cr.file.Load()
+	if err != nil {
+		cr.log.Error("Config isn't reloaded", zap.Error(err))
+		return
+	}
+	interval, err := cfg.InnerConfig.ParseInterval()
+	if err != nil {
+		cr.log.Error("Config isn't reloaded", zap.Error(err))
+		return
+	}
+	arrivals, err := scheduler.NewDistribution(cfg.InnerConfig.Arrival, interval)
+	if err != nil {
+		cr.log.Error("Config isn't reloaded", zap.Error(err))
+		return
+	}
+
+	old := cr.storage.GetConfig()
+	cr.storage.SetConfig(cfg)
+	if old != nil && reflect.DeepEqual(old.InnerConfig, cfg.InnerConfig) {
+		cr.log.Debug("Arrival settings aren't changed")
+		return
+	}
+	cr.producer.SetArrivals(arrivals)
+	cr.log.Info("Arrival settings applied",
+		zap.Duration("interval", interval),
+		zap.String("distribution", cfg.InnerConfig.Arrival.Type),
+	)
+}
@@ -0,0 +1,266 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/config"
+	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/repositories"
+	common_config "github.com/PonomarevAlexxander/queuing-system/utils/config"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+	"github.com/PonomarevAlexxander/queuing-system/utils/scheduler"
+)
+
+func TestUsecases(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Usecases Suite")
+}
+
+type fakeConfigFile struct {
+	mu      sync.Mutex
+	changed bool
+	cfg     *config.IncedentProducerConfig
+	err     error
+}
+
+func (f *fakeConfigFile) Changed() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	changed := f.changed
+	f.changed = false
+	return changed
+}
+
+func (f *fakeConfigFile) Load() (*config.IncedentProducerConfig, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.cfg, f.err
+}
+
+func (f *fakeConfigFile) update(cfg *config.IncedentProducerConfig, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.changed = true
+	f.cfg = cfg
+	f.err = err
+}
+
+// replace changes config without changing the file, like editor which keeps modification time
+func (f *fakeConfigFile) replace(cfg *config.IncedentProducerConfig) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cfg = cfg
+}
+
+type fakeArrivalsSetter struct {
+	applied chan scheduler.BackoffGetter
+}
+
+func (f *fakeArrivalsSetter) SetArrivals(arrivals scheduler.BackoffGetter) {
+	f.applied <- arrivals
+}
+
+var _ = Describe("ConfigReloader", func() {
+	var (
+		clk      *clock.Mock
+		file     *fakeConfigFile
+		storage  *repositories.ConfigStorage
+		producer *fakeArrivalsSetter
+		reloader *ConfigReloader
+		cancel   context.CancelFunc
+		done     chan struct{}
+	)
+
+	producerConfig := func(interval string) *config.IncedentProducerConfig {
+		return &config.IncedentProducerConfig{
+			InnerConfig: config.InnerConfig{
+				Interval: interval,
+				Arrival:  common_config.DistributionConfig{Type: "deterministic"},
+			},
+		}
+	}
+
+	// tick moves clock until reloader checks the file
+	tick := func() {
+		Eventually(func() bool {
+			clk.Add(time.Second)
+			file.mu.Lock()
+			defer file.mu.Unlock()
+			return file.changed
+		}).Should(BeFalse())
+	}
+
+	BeforeEach(func() {
+		clk = clock.NewMock()
+		file = &fakeConfigFile{}
+		storage = repositories.NewConfigStorage()
+		storage.SetConfig(producerConfig("1s"))
+		producer = &fakeArrivalsSetter{applied: make(chan scheduler.BackoffGetter, 1)}
+		reloader = NewConfigReloader(logger.InitZapWrapper(zap.NewNop()), clk, file, storage, producer, time.Second)
+
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		done = make(chan struct{})
+		go func() {
+			defer close(done)
+			Expect(reloader.Run(ctx)).To(Succeed())
+		}()
+	})
+
+	AfterEach(func() {
+		cancel()
+		Eventually(done).Should(BeClosed())
+	})
+
+	It("Applies changed intervals", func() {
+		file.update(producerConfig("250ms"), nil)
+		tick()
+
+		var arrivals scheduler.BackoffGetter
+		Eventually(producer.applied).Should(Receive(&arrivals))
+		Expect(arrivals.NextInterval()).To(Equal(250 * time.Millisecond))
+		Eventually(func() string { return storage.GetConfig().InnerConfig.Interval }).Should(Equal("250ms"))
+	})
+
+	It("Keeps previous config if new one is invalid", func() {
+		file.update(nil, errors.New("invalid config"))
+		tick()
+		file.update(producerConfig("not a duration"), nil)
+		tick()
+
+		Consistently(producer.applied).ShouldNot(Receive())
+		Expect(storage.GetConfig().InnerConfig.Interval).To(Equal("1s"))
+	})
+
+	It("Ignores unchanged arrival settings", func() {
+		file.update(producerConfig("1s"), nil)
+		tick()
+
+		Consistently(producer.applied).ShouldNot(Receive())
+	})
+
+	It("Doesn't reload unchanged file on watch", func() {
+		file.replace(producerConfig("250ms"))
+		clk.Add(5 * time.Second)
+
+		Consistently(producer.applied).ShouldNot(Receive())
+	})
+
+	It("Reloads config on SIGHUP", func() {
+		file.replace(producerConfig("250ms"))
+		reloader.signals <- syscall.SIGHUP
+
+		var arrivals scheduler.BackoffGetter
+		Eventually(producer.applied).Should(Receive(&arrivals))
+		Expect(arrivals.NextInterval()).To(Equal(250 * time.Millisecond))
+	})
+})
+
+type sentIncedents chan domain.Incedent
+
+func (s sentIncedents) SendIncedent(_ context.Context, incedent domain.Incedent) error {
+	s <- incedent
+	return nil
+}
+
+var _ = Describe("ConfigReloader of running producer", func() {
+	var (
+		clk    *clock.Mock
+		path   string
+		sent   sentIncedents
+		cancel context.CancelFunc
+		done   sync.WaitGroup
+	)
+
+	writeConfig := func(interval, watchInterval string) {
+		content := fmt.Sprintf(`logger:
+  level: info
+  out:
+    - stdout
+  type: console
+  stacktrace: true
+dispatcher:
+  host: localhost:3080
+incedent-producer:
+  interval: %s
+  arrival:
+    type: deterministic
+reload:
+  enabled: true
+  watch-interval: %s
+`, interval, watchInterval)
+		Expect(os.WriteFile(path, []byte(content), 0o644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		clk = clock.NewMock()
+		path = filepath.Join(GinkgoT().TempDir(), "config.yaml")
+		writeConfig("1h", "1s")
+		file := repositories.NewConfigFile(path)
+		cfg, err := file.Load()
+		Expect(err).NotTo(HaveOccurred())
+		storage := repositories.NewConfigStorage()
+		storage.SetConfig(cfg)
+
+		log := logger.InitZapWrapper(zap.NewNop())
+		sent = make(sentIncedents, 16)
+		producer := NewIncedentProducer(
+			log, clk, sent, scheduler.NewScheduler(log, clk),
+			scheduler.NewLinearBackoff(time.Hour), 1, domain.Incedent{},
+		)
+		reloader := NewConfigReloader(log, clk, file, storage, producer, time.Second)
+
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		done.Add(2)
+		go func() {
+			defer done.Done()
+			Expect(producer.Run(ctx)).To(Succeed())
+		}()
+		go func() {
+			defer done.Done()
+			Expect(reloader.Run(ctx)).To(Succeed())
+		}()
+	})
+
+	AfterEach(func() {
+		cancel()
+		done.Wait()
+	})
+
+	It("Reschedules producer with new interval", func() {
+		writeConfig("250ms", "1s")
+
+		// producer waits an hour unless it is rescheduled by reloader
+		Eventually(func() int {
+			clk.Add(250 * time.Millisecond)
+			return len(sent)
+		}).Should(BeNumerically(">", 0))
+	})
+
+	It("Validates reloaded config", func() {
+		writeConfig("250ms", "0s")
+
+		Consistently(func() int {
+			clk.Add(250 * time.Millisecond)
+			return len(sent)
+		}).Should(BeZero())
+	})
+})
@@ -4,11 +4,11 @@ import (
 	"context"
 	"fmt"
 	"sync/atomic"
-	"time"
 
 	"github.com/benbjohnson/clock"
 	"go.uber.org/zap"
 
+	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/config"
 	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/domain"
 	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
 	"github.com/PonomarevAlexxander/queuing-system/utils/scheduler"
@@ -19,10 +19,12 @@ type dispatcherClient interface {
 }
 
 type configStorage interface {
-	GetConfig()
+	GetConfig() *config.IncedentProducerConfig
+	SetConfig(cfg *config.IncedentProducerConfig)
 }
 
 type scheduledRunner interface {
+	Reschedule(backoff scheduler.BackoffGetter)
 	Run(ctx context.Context, backoff scheduler.BackoffGetter, task scheduler.ScheduledTask)
 	Stop()
 }
@@ -34,9 +36,11 @@ type IncedentProducer struct {
 	client dispatcherClient
 	runner scheduledRunner
 
-	interval time.Duration
+	arrivals scheduler.BackoffGetter
 	counter  atomic.Uint64
 	priority domain.Priority
+	// template keeps metadata of generated incedents
+	template domain.Incedent
 }
 
 func NewIncedentProducer(
@@ -44,8 +48,9 @@ func NewIncedentProducer(
 	clk clock.Clock,
 	client dispatcherClient,
 	runner scheduledRunner,
-	interval time.Duration,
+	arrivals scheduler.BackoffGetter,
 	priority domain.Priority,
+	template domain.Incedent,
 ) *IncedentProducer {
 	return &IncedentProducer{
 		log:      log,
@@ -53,22 +58,27 @@ func NewIncedentProducer(
 		client:   client,
 		runner:   runner,
 		priority: priority,
-		interval: interval,
+		arrivals: arrivals,
+		template: template,
 	}
 }
 
 func (ip *IncedentProducer) Run(ctx context.Context) error {
-	ip.runner.Run(ctx, scheduler.NewLinearBackoff(ip.interval), ip.generateIncedent)
+	ip.runner.Run(ctx, ip.arrivals, ip.generateIncedent)
 
 	return nil
 }
 
+// SetArrivals replaces intervals of running producer, incedents being sent aren't affected
+func (ip *IncedentProducer) SetArrivals(arrivals scheduler.BackoffGetter) {
+	ip.runner.Reschedule(arrivals)
+}
+
 func (ip *IncedentProducer) generateIncedent(ctx context.Context) error {
-	incedent := domain.Incedent{
-		Id:           ip.counter.Add(1),
-		CreationTime: ip.clk.Now(),
-		Priority:     ip.priority,
-	}
+	incedent := ip.template
+	incedent.Id = ip.counter.Add(1)
+	incedent.CreationTime = ip.clk.Now()
+	incedent.Priority = ip.priority
 	if err := ip.client.SendIncedent(ctx, incedent); err != nil {
 		return fmt.Errorf("failed to send incedent: %w", err)
 	}
@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+type fakeDispatcherClient struct {
+	sent []domain.Incedent
+}
+
+func (f *fakeDispatcherClient) SendIncedent(_ context.Context, incedent domain.Incedent) error {
+	f.sent = append(f.sent, incedent)
+	return nil
+}
+
+var _ = Describe("IncedentProducer", func() {
+	It("Attaches metadata to generated incedents", func() {
+		clk := clock.NewMock()
+		client := &fakeDispatcherClient{}
+		template := domain.Incedent{
+			Source:   "producer-1",
+			Category: "network",
+			Labels:   map[string]string{"env": "dev"},
+			Payload:  domain.Payload{ContentType: "text/plain", Data: []byte("hello")},
+		}
+		producer := NewIncedentProducer(logger.InitZapWrapper(zap.NewNop()), clk, client, nil, nil, 3, template)
+
+		Expect(producer.generateIncedent(context.Background())).To(Succeed())
+		Expect(producer.generateIncedent(context.Background())).To(Succeed())
+
+		expected := template
+		expected.Id = 2
+		expected.CreationTime = clk.Now()
+		expected.Priority = 3
+		Expect(client.sent).To(HaveLen(2))
+		Expect(client.sent[1]).To(Equal(expected))
+	})
+})
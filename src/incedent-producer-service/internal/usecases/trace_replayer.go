@@ -0,0 +1,152 @@
+package usecases
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+// TraceReplayer sends captured incedents keeping their inter-arrival gaps
+type TraceReplayer struct {
+	log *logger.Logger
+	clk clock.Clock
+
+	client  dispatcherClient
+	records []domain.TraceRecord
+	speed   float64
+	loop    bool
+	// template keeps metadata of replayed incedents
+	template domain.Incedent
+
+	stopped  chan struct{}
+	stopOnce sync.Once
+}
+
+// NewTraceReplayer creates replayer, records must be sorted by offset
+func NewTraceReplayer(
+	log *logger.Logger,
+	clk clock.Clock,
+	client dispatcherClient,
+	records []domain.TraceRecord,
+	speed float64,
+	loop bool,
+	template domain.Incedent,
+) *TraceReplayer {
+	return &TraceReplayer{
+		log:      log,
+		clk:      clk,
+		client:   client,
+		records:  records,
+		speed:    speed,
+		loop:     loop,
+		template: template,
+		stopped:  make(chan struct{}),
+	}
+}
+
+func (tr *TraceReplayer) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	// ids are shifted on every loop to stay unique
+	var idShift uint64
+	for _, record := range tr.records {
+		idShift = max(idShift, record.Id+1)
+	}
+	period := tr.period()
+
+	start := tr.clk.Now()
+	for round := uint64(0); ; round++ {
+		for _, record := range tr.records {
+			if !tr.waitUntil(ctx, start.Add(tr.scale(record.Offset))) {
+				return nil
+			}
+
+			incedent := tr.template
+			incedent.Id = record.Id + round*idShift
+			incedent.CreationTime = tr.clk.Now()
+			incedent.Priority = record.Priority
+			wg.Add(1)
+			go func() {
+				defer tr.log.LogPanic()
+				defer wg.Done()
+
+				if err := tr.client.SendIncedent(ctx, incedent); err != nil {
+					tr.log.Error("Failed to send incedent", zap.Stringer("incedent", incedent), zap.Error(err))
+					return
+				}
+				tr.log.Debug("Incedent replayed", zap.Stringer("incedent", incedent))
+			}()
+		}
+
+		if !tr.loop || period <= 0 {
+			if tr.loop {
+				tr.log.Warn("Trace has no gaps to loop over")
+			}
+			tr.log.Info("Trace replayed", zap.Uint64("rounds", round+1))
+			return nil
+		}
+		start = start.Add(tr.scale(period))
+	}
+}
+
+func (tr *TraceReplayer) Stop() {
+	tr.stopOnce.Do(func() {
+		close(tr.stopped)
+	})
+}
+
+// waitUntil returns false if replay was stopped
+func (tr *TraceReplayer) waitUntil(ctx context.Context, at time.Time) bool {
+	wait := at.Sub(tr.clk.Now())
+	if wait <= 0 {
+		return !tr.isStopped(ctx)
+	}
+
+	timer := tr.clk.Timer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	case <-tr.stopped:
+		return false
+	}
+}
+
+func (tr *TraceReplayer) isStopped(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	case <-tr.stopped:
+		return true
+	default:
+		return false
+	}
+}
+
+// period is offset of the next round, the first incedent of the round follows
+// the last one of the previous round after the last inter-arrival gap of the trace
+func (tr *TraceReplayer) period() time.Duration {
+	n := len(tr.records)
+	switch n {
+	case 0:
+		return 0
+	case 1:
+		return tr.records[0].Offset
+	}
+	lastGap := tr.records[n-1].Offset - tr.records[n-2].Offset
+
+	return tr.records[n-1].Offset - tr.records[0].Offset + lastGap
+}
+
+func (tr *TraceReplayer) scale(offset time.Duration) time.Duration {
+	return time.Duration(float64(offset) / tr.speed)
+}
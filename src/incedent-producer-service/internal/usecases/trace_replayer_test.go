@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/incedent-producer-service/internal/domain"
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+// replayClient reports every sent incedent, incedents are sent concurrently
+type replayClient struct {
+	sent chan domain.Incedent
+}
+
+func (c *replayClient) SendIncedent(_ context.Context, incedent domain.Incedent) error {
+	c.sent <- incedent
+	return nil
+}
+
+var _ = Describe("TraceReplayer", func() {
+	var (
+		clk    *clock.Mock
+		client *replayClient
+		cancel context.CancelFunc
+		done   chan struct{}
+	)
+
+	records := []domain.TraceRecord{
+		{Offset: 0, Priority: 1, Id: 1},
+		{Offset: 2 * time.Second, Priority: 2, Id: 2},
+		{Offset: 3 * time.Second, Priority: 1, Id: 3},
+	}
+
+	run := func(speed float64, loop bool) {
+		clk = clock.NewMock()
+		client = &replayClient{sent: make(chan domain.Incedent, len(records))}
+		replayer := NewTraceReplayer(logger.InitZapWrapper(zap.NewNop()), clk, client, records, speed, loop,
+			domain.Incedent{Source: "trace"})
+
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		done = make(chan struct{})
+		go func() {
+			defer close(done)
+			Expect(replayer.Run(ctx)).To(Succeed())
+		}()
+	}
+
+	// next moves the clock until the next incedent is sent
+	next := func() domain.Incedent {
+		var incedent domain.Incedent
+		Eventually(func() chan domain.Incedent {
+			clk.Add(100 * time.Millisecond)
+			return client.sent
+		}).Should(Receive(&incedent))
+		Expect(incedent.CreationTime).To(Equal(clk.Now()))
+
+		return incedent
+	}
+
+	// offsets are measured from the first incedent, the first record of the trace has zero offset
+	replay := func(n int) ([]uint64, []time.Duration) {
+		ids := make([]uint64, 0, n)
+		offsets := make([]time.Duration, 0, n)
+		var start time.Time
+		for i := range n {
+			incedent := next()
+			if i == 0 {
+				start = incedent.CreationTime
+			}
+			ids = append(ids, incedent.Id)
+			offsets = append(offsets, incedent.CreationTime.Sub(start))
+		}
+
+		return ids, offsets
+	}
+
+	AfterEach(func() {
+		cancel()
+		Eventually(done).Should(BeClosed())
+	})
+
+	DescribeTable("Keeps inter-arrival gaps",
+		func(speed float64) {
+			run(speed, false)
+			var start time.Time
+			for i, record := range records {
+				incedent := next()
+				if i == 0 {
+					start = incedent.CreationTime
+				}
+				Expect(incedent.Id).To(Equal(record.Id))
+				Expect(incedent.Priority).To(Equal(record.Priority))
+				Expect(incedent.Source).To(Equal("trace"))
+				Expect(incedent.CreationTime.Sub(start)).To(Equal(time.Duration(float64(record.Offset) / speed)))
+			}
+			Eventually(done).Should(BeClosed())
+		},
+		Entry("real time", 1.0),
+		Entry("twice faster", 2.0),
+	)
+
+	It("Loop carries the last gap into the next round", func() {
+		run(1, true)
+		ids, offsets := replay(2 * len(records))
+
+		// the last gap of the trace is one second
+		Expect(offsets).To(Equal([]time.Duration{
+			0, 2 * time.Second, 3 * time.Second,
+			4 * time.Second, 6 * time.Second, 7 * time.Second,
+		}))
+		Expect(ids).To(Equal([]uint64{1, 2, 3, 5, 6, 7}))
+	})
+})
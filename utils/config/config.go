@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"gopkg.in/yaml.v2"
@@ -24,6 +25,29 @@ type ClientConfig struct {
 	Host string `yaml:"host" validate:"required,hostname_port"`
 }
 
+// DistributionConfig describes random intervals, mean is configured by the service
+type DistributionConfig struct {
+	Type string `yaml:"type" validate:"omitempty,oneof='deterministic' 'exponential' 'uniform' 'erlang' 'hyperexponential' 'normal' 'lognormal' 'empirical'"`
+	// Seed makes intervals reproducible, 0 means random seed
+	Seed uint64 `yaml:"seed"`
+	// Min and Max are bounds of uniform distribution, for normal one they are optional truncation bounds
+	Min string `yaml:"min" validate:"required_if=Type uniform"`
+	Max string `yaml:"max" validate:"required_if=Type uniform"`
+	// StdDev is standard deviation of normal and lognormal distributions
+	StdDev string `yaml:"std-dev"`
+	// K is number of erlang phases
+	K      int           `yaml:"k" validate:"required_if=Type erlang,omitempty,min=1"`
+	Phases []PhaseConfig `yaml:"phases" validate:"required_if=Type hyperexponential,dive"`
+	// Histogram is a path to csv file with 'from,to,weight' bins of empirical distribution
+	Histogram string `yaml:"histogram" validate:"required_if=Type empirical"`
+}
+
+// PhaseConfig is a branch of hyperexponential distribution
+type PhaseConfig struct {
+	Probability float64 `yaml:"probability" validate:"gt=0,lte=1"`
+	Mean        string  `yaml:"mean" validate:"required,positive_duration"`
+}
+
 var (
 	validate *validator.Validate
 	once     sync.Once
@@ -32,6 +56,9 @@ var (
 func ValidateConfig(config interface{}) error {
 	once.Do(func() {
 		validate = validator.New()
+		if err := validate.RegisterValidation("positive_duration", positiveDuration); err != nil {
+			panic(err)
+		}
 	})
 
 	err := validate.Struct(config)
@@ -41,6 +68,12 @@ func ValidateConfig(config interface{}) error {
 	return nil
 }
 
+// positiveDuration checks that string is a duration above zero, intervals and timeouts must be positive
+func positiveDuration(fl validator.FieldLevel) bool {
+	duration, err := time.ParseDuration(fl.Field().String())
+	return err == nil && duration > 0
+}
+
 func ReadConfigFromYAML[T any](path string) (*T, error) {
 	file, err := os.ReadFile(path)
 	if err != nil {
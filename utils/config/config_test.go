@@ -88,5 +88,12 @@ var _ = Describe("Config", func() {
 			testExpectedConfig.LoggerConfig.Level = "unkown"
 			Expect(ValidateConfig(&testExpectedConfig)).NotTo(Succeed())
 		})
+
+		It("Phase mean must be positive duration", func() {
+			Expect(ValidateConfig(&PhaseConfig{Probability: 1, Mean: "1s"})).To(Succeed())
+			for _, mean := range []string{"0s", "-1s", "second"} {
+				Expect(ValidateConfig(&PhaseConfig{Probability: 1, Mean: mean})).NotTo(Succeed())
+			}
+		})
 	})
 })
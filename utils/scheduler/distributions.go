@@ -0,0 +1,290 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/PonomarevAlexxander/queuing-system/utils/config"
+)
+
+const (
+	DeterministicDistribution    = "deterministic"
+	ExponentialDistribution      = "exponential"
+	UniformDistribution          = "uniform"
+	ErlangDistribution           = "erlang"
+	HyperexponentialDistribution = "hyperexponential"
+	NormalDistribution           = "normal"
+	LognormalDistribution        = "lognormal"
+	EmpiricalDistribution        = "empirical"
+)
+
+// attempts to sample truncated normal distribution before falling back to the bound
+const truncatedSamplingAttempts = 100
+
+var (
+	errBadProbabilities = errors.New("phase probabilities must sum up to 1")
+	errNoStdDev         = errors.New("std-dev is required")
+	errEmptyHistogram   = errors.New("histogram has no bins")
+	errNonPositiveMean  = errors.New("mean must be positive")
+)
+
+// NewRand creates generator for distributions, zero seed gives random one
+func NewRand(seed uint64) *rand.Rand {
+	if seed == 0 {
+		seed = rand.Uint64()
+	}
+
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+// NewDistribution creates intervals generator described by cfg with the given mean,
+// deterministic intervals are used by default.
+// Generators aren't safe for concurrent use.
+func NewDistribution(cfg config.DistributionConfig, mean time.Duration) (BackoffGetter, error) {
+	rng := NewRand(cfg.Seed)
+	switch cfg.Type {
+	case "", DeterministicDistribution:
+		return NewLinearBackoff(mean), nil
+	case ExponentialDistribution:
+		return NewExponential(rng, mean), nil
+	case UniformDistribution:
+		minInterval, err := time.ParseDuration(cfg.Min)
+		if err != nil {
+			return nil, fmt.Errorf("uniform min: %w", err)
+		}
+		maxInterval, err := time.ParseDuration(cfg.Max)
+		if err != nil {
+			return nil, fmt.Errorf("uniform max: %w", err)
+		}
+		if maxInterval < minInterval {
+			return nil, fmt.Errorf("uniform max %s is less than min %s", maxInterval, minInterval)
+		}
+		return NewUniform(rng, minInterval, maxInterval), nil
+	case ErlangDistribution:
+		return NewErlang(rng, cfg.K, mean), nil
+	case HyperexponentialDistribution:
+		probabilities := make([]float64, 0, len(cfg.Phases))
+		means := make([]time.Duration, 0, len(cfg.Phases))
+		var total float64
+		for _, phase := range cfg.Phases {
+			phaseMean, err := time.ParseDuration(phase.Mean)
+			if err != nil {
+				return nil, fmt.Errorf("hyperexponential phase mean: %w", err)
+			}
+			probabilities = append(probabilities, phase.Probability)
+			means = append(means, phaseMean)
+			total += phase.Probability
+		}
+		if math.Abs(total-1) > 1e-9 {
+			return nil, errBadProbabilities
+		}
+		return NewHyperexponential(rng, probabilities, means), nil
+	case NormalDistribution:
+		stdDev, err := parseStdDev(cfg.StdDev)
+		if err != nil {
+			return nil, err
+		}
+		lower, upper, err := parseBounds(cfg.Min, cfg.Max)
+		if err != nil {
+			return nil, err
+		}
+		return NewTruncatedNormal(rng, mean, stdDev, lower, upper), nil
+	case LognormalDistribution:
+		if mean <= 0 {
+			return nil, fmt.Errorf("lognormal mean %s: %w", mean, errNonPositiveMean)
+		}
+		stdDev, err := parseStdDev(cfg.StdDev)
+		if err != nil {
+			return nil, err
+		}
+		return NewLognormal(rng, mean, stdDev), nil
+	case EmpiricalDistribution:
+		bins, err := ReadHistogram(cfg.Histogram)
+		if err != nil {
+			return nil, err
+		}
+		return NewEmpirical(rng, bins)
+	default:
+		return nil, fmt.Errorf("unknown distribution '%s'", cfg.Type)
+	}
+}
+
+// Exponential gives intervals of Poisson process
+type Exponential struct {
+	rng  *rand.Rand
+	mean time.Duration
+}
+
+func NewExponential(rng *rand.Rand, mean time.Duration) *Exponential {
+	return &Exponential{
+		rng:  rng,
+		mean: mean,
+	}
+}
+
+func (e *Exponential) NextInterval() time.Duration {
+	return scale(e.mean, e.rng.ExpFloat64())
+}
+
+type Uniform struct {
+	rng *rand.Rand
+	min time.Duration
+	max time.Duration
+}
+
+func NewUniform(rng *rand.Rand, minInterval, maxInterval time.Duration) *Uniform {
+	return &Uniform{
+		rng: rng,
+		min: minInterval,
+		max: maxInterval,
+	}
+}
+
+func (u *Uniform) NextInterval() time.Duration {
+	return u.min + scale(u.max-u.min, u.rng.Float64())
+}
+
+// Erlang is a sum of k exponential phases, each with mean/k
+type Erlang struct {
+	rng  *rand.Rand
+	k    int
+	mean time.Duration
+}
+
+func NewErlang(rng *rand.Rand, k int, mean time.Duration) *Erlang {
+	return &Erlang{
+		rng:  rng,
+		k:    max(k, 1),
+		mean: mean,
+	}
+}
+
+func (e *Erlang) NextInterval() time.Duration {
+	var sum float64
+	for range e.k {
+		sum += e.rng.ExpFloat64()
+	}
+
+	return scale(e.mean, sum/float64(e.k))
+}
+
+// Hyperexponential chooses exponential phase with its probability
+type Hyperexponential struct {
+	rng           *rand.Rand
+	probabilities []float64
+	means         []time.Duration
+}
+
+func NewHyperexponential(rng *rand.Rand, probabilities []float64, means []time.Duration) *Hyperexponential {
+	return &Hyperexponential{
+		rng:           rng,
+		probabilities: probabilities,
+		means:         means,
+	}
+}
+
+func (h *Hyperexponential) NextInterval() time.Duration {
+	p := h.rng.Float64()
+	phase := len(h.means) - 1
+	for i, probability := range h.probabilities {
+		if p < probability {
+			phase = i
+			break
+		}
+		p -= probability
+	}
+
+	return scale(h.means[phase], h.rng.ExpFloat64())
+}
+
+// TruncatedNormal is normal distribution resampled until it fits [lower, upper]
+type TruncatedNormal struct {
+	rng    *rand.Rand
+	mean   time.Duration
+	stdDev time.Duration
+	lower  time.Duration
+	upper  time.Duration
+}
+
+func NewTruncatedNormal(rng *rand.Rand, mean, stdDev, lower, upper time.Duration) *TruncatedNormal {
+	return &TruncatedNormal{
+		rng:    rng,
+		mean:   mean,
+		stdDev: stdDev,
+		lower:  lower,
+		upper:  upper,
+	}
+}
+
+func (n *TruncatedNormal) NextInterval() time.Duration {
+	for range truncatedSamplingAttempts {
+		interval := n.mean + scale(n.stdDev, n.rng.NormFloat64())
+		if interval >= n.lower && interval <= n.upper {
+			return interval
+		}
+	}
+
+	return min(max(n.mean, n.lower), n.upper)
+}
+
+// Lognormal is parametrized by mean and standard deviation of intervals themselves
+type Lognormal struct {
+	rng   *rand.Rand
+	mu    float64
+	sigma float64
+}
+
+func NewLognormal(rng *rand.Rand, mean, stdDev time.Duration) *Lognormal {
+	m, s := mean.Seconds(), stdDev.Seconds()
+	sigma2 := math.Log(1 + s*s/(m*m))
+
+	return &Lognormal{
+		rng:   rng,
+		mu:    math.Log(m) - sigma2/2,
+		sigma: math.Sqrt(sigma2),
+	}
+}
+
+func (l *Lognormal) NextInterval() time.Duration {
+	return scale(time.Second, math.Exp(l.mu+l.sigma*l.rng.NormFloat64()))
+}
+
+func scale(d time.Duration, factor float64) time.Duration {
+	return time.Duration(float64(d) * factor)
+}
+
+func parseStdDev(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, errNoStdDev
+	}
+	stdDev, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("std-dev: %w", err)
+	}
+
+	return stdDev, nil
+}
+
+// parseBounds gives [0, max duration] for empty values
+func parseBounds(lowerValue, upperValue string) (time.Duration, time.Duration, error) {
+	lower, upper := time.Duration(0), time.Duration(math.MaxInt64)
+	var err error
+	if lowerValue != "" {
+		if lower, err = time.ParseDuration(lowerValue); err != nil {
+			return 0, 0, fmt.Errorf("min: %w", err)
+		}
+	}
+	if upperValue != "" {
+		if upper, err = time.ParseDuration(upperValue); err != nil {
+			return 0, 0, fmt.Errorf("max: %w", err)
+		}
+	}
+	if upper < lower {
+		return 0, 0, fmt.Errorf("max %s is less than min %s", upper, lower)
+	}
+
+	return lower, upper, nil
+}
@@ -0,0 +1,107 @@
+package scheduler
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/PonomarevAlexxander/queuing-system/utils/config"
+)
+
+const (
+	testSeed        = 42
+	testSampleSize  = 100000
+	testMean        = time.Second
+	testMeanEpsilon = 0.02
+)
+
+func TestScheduler(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Scheduler Suite")
+}
+
+func sampleMean(backoff BackoffGetter) float64 {
+	var sum time.Duration
+	for range testSampleSize {
+		sum += backoff.NextInterval()
+	}
+
+	return (sum / testSampleSize).Seconds()
+}
+
+var _ = Describe("Distributions", func() {
+	DescribeTable("Sample mean matches configured one",
+		func(cfg config.DistributionConfig, expected time.Duration) {
+			cfg.Seed = testSeed
+			backoff, err := NewDistribution(cfg, testMean)
+			Expect(err).To(Succeed())
+			Expect(sampleMean(backoff)).To(BeNumerically("~", expected.Seconds(), testMeanEpsilon))
+		},
+		Entry("deterministic", config.DistributionConfig{}, testMean),
+		Entry("exponential", config.DistributionConfig{Type: ExponentialDistribution}, testMean),
+		Entry("uniform", config.DistributionConfig{Type: UniformDistribution, Min: "500ms", Max: "2500ms"}, 1500*time.Millisecond),
+		Entry("erlang", config.DistributionConfig{Type: ErlangDistribution, K: 3}, testMean),
+		Entry("hyperexponential", config.DistributionConfig{
+			Type: HyperexponentialDistribution,
+			Phases: []config.PhaseConfig{
+				{Probability: 0.25, Mean: "2500ms"},
+				{Probability: 0.75, Mean: "500ms"},
+			},
+		}, testMean),
+		Entry("normal", config.DistributionConfig{Type: NormalDistribution, StdDev: "100ms"}, testMean),
+		Entry("lognormal", config.DistributionConfig{Type: LognormalDistribution, StdDev: "500ms"}, testMean),
+	)
+
+	It("Truncated normal stays in bounds", func() {
+		backoff, err := NewDistribution(config.DistributionConfig{
+			Type: NormalDistribution, Seed: testSeed, StdDev: "1s", Min: "900ms", Max: "1100ms",
+		}, testMean)
+		Expect(err).To(Succeed())
+		for range 1000 {
+			Expect(backoff.NextInterval()).To(BeNumerically("~", testMean, 100*time.Millisecond))
+		}
+	})
+
+	It("Empirical follows histogram", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "histogram.csv")
+		histogram := "# from,to,weight\n0s,1s,1\n1s,2s,0\n2s,3s,3\n"
+		Expect(os.WriteFile(path, []byte(histogram), 0o644)).To(Succeed())
+
+		backoff, err := NewDistribution(config.DistributionConfig{
+			Type: EmpiricalDistribution, Seed: testSeed, Histogram: path,
+		}, testMean)
+		Expect(err).To(Succeed())
+		// (0.5 * 1 + 2.5 * 3) / 4
+		Expect(sampleMean(backoff)).To(BeNumerically("~", 2.0, testMeanEpsilon))
+	})
+
+	It("Same seed gives same intervals", func() {
+		cfg := config.DistributionConfig{Type: ExponentialDistribution, Seed: testSeed}
+		first, err := NewDistribution(cfg, testMean)
+		Expect(err).To(Succeed())
+		second, err := NewDistribution(cfg, testMean)
+		Expect(err).To(Succeed())
+		for range 10 {
+			Expect(first.NextInterval()).To(Equal(second.NextInterval()))
+		}
+	})
+
+	It("Hyperexponential probabilities must sum up to 1", func() {
+		_, err := NewDistribution(config.DistributionConfig{
+			Type:   HyperexponentialDistribution,
+			Phases: []config.PhaseConfig{{Probability: 0.5, Mean: "1s"}},
+		}, testMean)
+		Expect(err).To(MatchError(errBadProbabilities))
+	})
+
+	It("Lognormal mean must be positive", func() {
+		for _, mean := range []time.Duration{0, -time.Second} {
+			_, err := NewDistribution(config.DistributionConfig{Type: LognormalDistribution, StdDev: "500ms"}, mean)
+			Expect(err).To(MatchError(errNonPositiveMean))
+		}
+	})
+})
@@ -0,0 +1,112 @@
+package scheduler
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HistogramBin is interval [From, To) observed Weight times
+type HistogramBin struct {
+	From   time.Duration
+	To     time.Duration
+	Weight float64
+}
+
+// ReadHistogram reads csv file with 'from,to,weight' lines, '#' starts a comment
+func ReadHistogram(path string) ([]HistogramBin, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open histogram: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	bins := make([]HistogramBin, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read histogram: %w", err)
+		}
+		bin, err := parseBin(record)
+		if err != nil {
+			line, _ := reader.FieldPos(0)
+			return nil, fmt.Errorf("histogram line %d: %w", line, err)
+		}
+		bins = append(bins, bin)
+	}
+
+	return bins, nil
+}
+
+func parseBin(record []string) (HistogramBin, error) {
+	from, err := time.ParseDuration(strings.TrimSpace(record[0]))
+	if err != nil {
+		return HistogramBin{}, err
+	}
+	to, err := time.ParseDuration(strings.TrimSpace(record[1]))
+	if err != nil {
+		return HistogramBin{}, err
+	}
+	weight, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+	if err != nil {
+		return HistogramBin{}, err
+	}
+	if to < from || weight < 0 {
+		return HistogramBin{}, fmt.Errorf("bad bin [%s, %s) with weight %v", from, to, weight)
+	}
+
+	return HistogramBin{From: from, To: to, Weight: weight}, nil
+}
+
+// Empirical chooses histogram bin proportionally to its weight
+// and gives uniform interval inside of it
+type Empirical struct {
+	rng  *rand.Rand
+	bins []HistogramBin
+	// cumulative weights of bins
+	cumulative []float64
+}
+
+func NewEmpirical(rng *rand.Rand, bins []HistogramBin) (*Empirical, error) {
+	cumulative := make([]float64, 0, len(bins))
+	var total float64
+	for _, bin := range bins {
+		total += bin.Weight
+		cumulative = append(cumulative, total)
+	}
+	if total <= 0 {
+		return nil, errEmptyHistogram
+	}
+
+	return &Empirical{
+		rng:        rng,
+		bins:       bins,
+		cumulative: cumulative,
+	}, nil
+}
+
+func (e *Empirical) NextInterval() time.Duration {
+	p := e.rng.Float64() * e.cumulative[len(e.cumulative)-1]
+	i := sort.SearchFloat64s(e.cumulative, p)
+	if i == len(e.bins) {
+		i--
+	}
+	bin := e.bins[i]
+
+	return bin.From + scale(bin.To-bin.From, e.rng.Float64())
+}
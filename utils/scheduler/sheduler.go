@@ -18,30 +18,36 @@ type BackoffGetter interface {
 }
 
 type Scheduler struct {
-	log     *logger.Logger
-	clk     clock.Clock
-	stopped chan struct{}
+	log      *logger.Logger
+	clk      clock.Clock
+	stopped  chan struct{}
+	backoffs chan BackoffGetter
 }
 
 func NewScheduler(log *logger.Logger, clk clock.Clock) *Scheduler {
 	return &Scheduler{
-		log:     log,
-		clk:     clk,
-		stopped: make(chan struct{}),
+		log:      log,
+		clk:      clk,
+		stopped:  make(chan struct{}),
+		backoffs: make(chan BackoffGetter, 1),
 	}
 }
 
 // Run blocks until scheduler stopps
 func (s *Scheduler) Run(ctx context.Context, backoff BackoffGetter, task ScheduledTask) {
-	ticker := s.clk.Ticker(backoff.NextInterval())
+	ticker := s.clk.Ticker(nextInterval(backoff))
 	defer ticker.Stop()
 	var wg sync.WaitGroup
 
 outer:
 	for {
 		select {
+		case backoff = <-s.backoffs:
+			// next task is planned again, so long old interval isn't waited out
+			ticker.Reset(nextInterval(backoff))
+			s.log.Info("Scheduler intervals replaced")
 		case <-ticker.C:
-			ticker.Reset(backoff.NextInterval())
+			ticker.Reset(nextInterval(backoff))
 			wg.Add(1)
 			go func() {
 				defer s.log.LogPanic()
@@ -66,6 +72,28 @@ outer:
 	wg.Wait()
 }
 
+// Reschedule replaces intervals of running scheduler, tasks in progress aren't affected,
+// it doesn't block and only the last backoff is applied if it is called several times
+func (s *Scheduler) Reschedule(backoff BackoffGetter) {
+	for {
+		select {
+		case s.backoffs <- backoff:
+			return
+		default:
+		}
+		// drop backoff which isn't applied yet
+		select {
+		case <-s.backoffs:
+		default:
+		}
+	}
+}
+
 func (s *Scheduler) Stop() {
 	close(s.stopped)
 }
+
+// nextInterval keeps intervals positive, random distributions can give zero
+func nextInterval(backoff BackoffGetter) time.Duration {
+	return max(backoff.NextInterval(), time.Nanosecond)
+}
@@ -0,0 +1,42 @@
+package scheduler
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/PonomarevAlexxander/queuing-system/utils/logger"
+)
+
+var _ = Describe("Scheduler", func() {
+	It("Applies new intervals without restart", func() {
+		clk := clock.NewMock()
+		scheduler := NewScheduler(logger.InitZapWrapper(zap.NewNop()), clk)
+		var runs atomic.Int64
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			scheduler.Run(context.Background(), NewLinearBackoff(time.Hour), func(context.Context) error {
+				runs.Add(1)
+				return nil
+			})
+		}()
+
+		scheduler.Reschedule(NewLinearBackoff(10 * time.Second))
+		scheduler.Reschedule(NewLinearBackoff(time.Second))
+		// scheduler picks backoff up asynchronously
+		Eventually(func() int64 {
+			clk.Add(time.Second)
+			return runs.Load()
+		}).Should(BeNumerically(">=", 3))
+		Expect(clk.Now().Sub(time.Unix(0, 0))).To(BeNumerically("<", time.Hour))
+
+		scheduler.Stop()
+		Eventually(done).Should(BeClosed())
+	})
+})